@@ -0,0 +1,278 @@
+// Package alerts evaluates user-defined rules, such as "close game in the
+// fourth quarter", against games as they refresh.
+package alerts
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/elliota43/sportsterminal/api"
+	"github.com/elliota43/sportsterminal/config"
+)
+
+// Facts are what a rule knows about a game.
+type Facts struct {
+	Sport  string
+	League string
+	GameID string
+
+	Away int
+	Home int
+
+	// Period is the current period, zero before the game starts.
+	Period int
+
+	// Regulation is the number of periods before overtime.
+	Regulation int
+
+	// State is "pre", "in" or "post".
+	State string
+
+	// Favorite is the betting favorite, "home" or "away", or "" when the
+	// game has no line.
+	Favorite string
+}
+
+// FromGame collects the facts about a scoreboard game.
+func FromGame(sport, league string, game api.Game) Facts {
+	f := Facts{
+		Sport:      sport,
+		League:     league,
+		GameID:     game.ID,
+		Away:       score(game.AwayTeam.Score),
+		Home:       score(game.HomeTeam.Score),
+		Period:     game.Period,
+		Regulation: api.RegulationPeriods(sport, league),
+		State:      game.State,
+	}
+	if f.State == "" {
+		switch {
+		case game.Completed:
+			f.State = "post"
+		case game.IsLive:
+			f.State = "in"
+		default:
+			f.State = "pre"
+		}
+	}
+	if game.Odds != nil {
+		f.Favorite = game.Odds.Favorite
+	}
+	return f
+}
+
+// FromDetail collects the facts about a game summary. Underdog rules only
+// match summaries that carry a betting line.
+func FromDetail(sport, league string, detail *api.GameDetail) Facts {
+	f := Facts{
+		Sport:      sport,
+		League:     league,
+		GameID:     detail.ID,
+		Away:       score(detail.AwayTeam.Score),
+		Home:       score(detail.HomeTeam.Score),
+		Regulation: api.RegulationPeriods(sport, league),
+		State:      detail.State,
+	}
+	if detail.Odds != nil {
+		f.Favorite = detail.Odds.Favorite
+	}
+
+	if period, err := strconv.Atoi(detail.Period); err == nil {
+		f.Period = period
+	} else {
+		f.Period = len(detail.HomeTeam.LineScores)
+	}
+
+	if f.State == "" {
+		// Summaries without a state: go by the status text
+		switch {
+		case detail.IsLive:
+			f.State = "in"
+		case strings.Contains(strings.ToLower(detail.Status+" "+detail.StatusDetail), "final"):
+			f.State = "post"
+		default:
+			f.State = "pre"
+		}
+	}
+	return f
+}
+
+func score(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// late returns the first period that counts as late in the game: the
+// final regulation period, or the seventh inning.
+func (f Facts) late() int {
+	if f.Sport == "baseball" {
+		return f.Regulation - 2
+	}
+	return f.Regulation
+}
+
+// closeMargin is a one-score game in the sport.
+func (f Facts) closeMargin() int {
+	switch f.Sport {
+	case "basketball":
+		return 5
+	case "football":
+		return 8
+	case "baseball":
+		return 2
+	case "hockey", "soccer":
+		return 1
+	}
+	return 3
+}
+
+func (f Facts) underdogLeading() bool {
+	switch f.Favorite {
+	case "home":
+		return f.Away > f.Home
+	case "away":
+		return f.Home > f.Away
+	}
+	return false
+}
+
+func intVar(get func(Facts) int) variable {
+	return variable{intKind, get}
+}
+
+func boolVar(get func(Facts) bool) variable {
+	return variable{boolKind, func(f Facts) int { return boolInt(get(f)) }}
+}
+
+// variables are the facts rules can use.
+var variables = map[string]variable{
+	"away":         intVar(func(f Facts) int { return f.Away }),
+	"home":         intVar(func(f Facts) int { return f.Home }),
+	"total":        intVar(func(f Facts) int { return f.Away + f.Home }),
+	"period":       intVar(func(f Facts) int { return f.Period }),
+	"periods":      intVar(func(f Facts) int { return f.Regulation }),
+	"close_margin": intVar(Facts.closeMargin),
+	"margin": intVar(func(f Facts) int {
+		if f.Away > f.Home {
+			return f.Away - f.Home
+		}
+		return f.Home - f.Away
+	}),
+
+	"pre":              boolVar(func(f Facts) bool { return f.State == "pre" }),
+	"live":             boolVar(func(f Facts) bool { return f.State == "in" }),
+	"final":            boolVar(func(f Facts) bool { return f.State == "post" }),
+	"tied":             boolVar(func(f Facts) bool { return f.State != "pre" && f.Away == f.Home }),
+	"second_half":      boolVar(func(f Facts) bool { return f.Period*2 > f.Regulation }),
+	"late":             boolVar(func(f Facts) bool { return f.Period >= f.late() }),
+	"overtime":         boolVar(func(f Facts) bool { return f.Period > f.Regulation }),
+	"underdog_leading": boolVar(Facts.underdogLeading),
+}
+
+// Rule is a compiled alert.
+type Rule struct {
+	Name string
+	When string
+
+	// Sport and League limit the rule when set.
+	Sport  string
+	League string
+
+	// Notify asks for a desktop notification when a game starts matching.
+	Notify bool
+
+	expr expr
+}
+
+// Compile parses a rule's condition.
+func Compile(alert config.Alert) (Rule, error) {
+	e, err := parse(alert.When)
+	if err != nil {
+		return Rule{}, fmt.Errorf("alert %q: %w", alert.Name, err)
+	}
+	return Rule{
+		Name:   alert.Name,
+		When:   alert.When,
+		Sport:  alert.Sport,
+		League: alert.League,
+		Notify: alert.Notify,
+		expr:   e,
+	}, nil
+}
+
+// Match reports whether a game satisfies the rule.
+func (r Rule) Match(f Facts) bool {
+	if r.Sport != "" && r.Sport != f.Sport || r.League != "" && r.League != f.League {
+		return false
+	}
+	return r.expr.eval(f) != 0
+}
+
+// Defaults are the built-in alerts.
+var Defaults = []config.Alert{
+	{Name: "Close game", When: "live && late && margin <= close_margin"},
+	{Name: "Upset watch", When: "live && second_half && underdog_leading"},
+	{Name: "Overtime", When: "live && overtime"},
+}
+
+// Engine evaluates a set of rules. A nil Engine matches nothing.
+type Engine struct {
+	Rules []Rule
+}
+
+// New compiles the built-in alerts with the config's alerts applied over
+// them: an alert named after a built-in one replaces it, or turns it off
+// when its condition is empty.
+func New(custom []config.Alert) (*Engine, error) {
+	alerts := append([]config.Alert(nil), Defaults...)
+	for _, alert := range custom {
+		replaced := false
+		for i := range alerts {
+			if strings.EqualFold(alerts[i].Name, alert.Name) {
+				alerts[i] = alert
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			alerts = append(alerts, alert)
+		}
+	}
+
+	engine := &Engine{}
+	for _, alert := range alerts {
+		if strings.TrimSpace(alert.When) == "" {
+			continue
+		}
+		rule, err := Compile(alert)
+		if err != nil {
+			return nil, err
+		}
+		engine.Rules = append(engine.Rules, rule)
+	}
+	return engine, nil
+}
+
+// Match returns the rules a game satisfies, in rule order.
+func (e *Engine) Match(f Facts) []Rule {
+	if e == nil {
+		return nil
+	}
+	var matched []Rule
+	for _, rule := range e.Rules {
+		if rule.Match(f) {
+			matched = append(matched, rule)
+		}
+	}
+	return matched
+}
+
+// Names returns the names of rules, for display.
+func Names(rules []Rule) []string {
+	names := make([]string, len(rules))
+	for i, rule := range rules {
+		names[i] = rule.Name
+	}
+	return names
+}
@@ -0,0 +1,104 @@
+package alerts
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/elliota43/sportsterminal/api"
+	"github.com/elliota43/sportsterminal/config"
+)
+
+// live returns the facts of a game in progress in a league.
+func live(sport, league string, period, away, home int) Facts {
+	return Facts{
+		Sport:      sport,
+		League:     league,
+		Away:       away,
+		Home:       home,
+		Period:     period,
+		Regulation: api.RegulationPeriods(sport, league),
+		State:      "in",
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	engine, err := New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	underdog := live("basketball", "nba", 3, 60, 55)
+	underdog.Favorite = "home"
+	favored := underdog
+	favored.Favorite = "away"
+	collegeUpset := live("basketball", "mens-college-basketball", 2, 40, 30)
+	collegeUpset.Favorite = "home"
+	finished := live("basketball", "nba", 4, 100, 99)
+	finished.State = "post"
+
+	tests := []struct {
+		name  string
+		facts Facts
+		want  []string
+	}{
+		{"NBA fourth quarter, close", live("basketball", "nba", 4, 100, 97), []string{"Close game"}},
+		{"NBA fourth quarter, not close", live("basketball", "nba", 4, 100, 90), nil},
+		{"NBA third quarter, close", live("basketball", "nba", 3, 70, 70), nil},
+		{"NBA overtime, tied", live("basketball", "nba", 5, 110, 110), []string{"Close game", "Overtime"}},
+		{"NBA underdog leading after halftime", underdog, []string{"Upset watch"}},
+		{"NBA favorite leading", favored, nil},
+		{"NBA first half, underdog leading", func() Facts { f := underdog; f.Period = 2; return f }(), nil},
+		{"NBA final", finished, nil},
+
+		// College basketball plays halves
+		{"college second half, close", live("basketball", "mens-college-basketball", 2, 60, 58), []string{"Close game"}},
+		{"college first half, close", live("basketball", "mens-college-basketball", 1, 30, 30), nil},
+		{"college second half, underdog leading", collegeUpset, []string{"Upset watch"}},
+		{"college first half, underdog leading", func() Facts { f := collegeUpset; f.Period = 1; return f }(), nil},
+		{"women's college fourth quarter", live("basketball", "womens-college-basketball", 4, 60, 58), []string{"Close game"}},
+
+		// Baseball is late from the seventh inning
+		{"MLB seventh, one run", live("baseball", "mlb", 7, 3, 2), []string{"Close game"}},
+		{"MLB sixth, one run", live("baseball", "mlb", 6, 3, 2), nil},
+		{"MLB ninth, three runs", live("baseball", "mlb", 9, 5, 2), nil},
+		{"MLB extra innings", live("baseball", "mlb", 10, 2, 2), []string{"Close game", "Overtime"}},
+
+		{"NFL fourth, one score", live("football", "nfl", 4, 17, 24), []string{"Close game"}},
+		{"NFL fourth, two scores", live("football", "nfl", 4, 17, 27), nil},
+		{"NHL third, one goal", live("hockey", "nhl", 3, 2, 1), []string{"Close game"}},
+		{"NHL second, one goal", live("hockey", "nhl", 2, 2, 1), nil},
+		{"Premier League second half, level", live("soccer", "eng.1", 2, 1, 1), []string{"Close game"}},
+	}
+	for _, tt := range tests {
+		got := Names(engine.Match(tt.facts))
+		if len(got) == 0 {
+			got = nil
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: matched %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNewOverrides(t *testing.T) {
+	engine, err := New([]config.Alert{
+		{Name: "close GAME", When: "live && margin <= 1"},
+		{Name: "Overtime"},
+		{Name: "Shootout", When: "total >= 250", Sport: "basketball"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"close GAME", "Upset watch", "Shootout"}
+	if got := Names(engine.Rules); !reflect.DeepEqual(got, want) {
+		t.Errorf("rules = %q, want %q", got, want)
+	}
+
+	if got := Names(engine.Match(live("football", "nfl", 4, 130, 125))); len(got) != 0 {
+		t.Errorf("football game matched %q, want the basketball-only rule skipped", got)
+	}
+
+	if _, err := New([]config.Alert{{Name: "Broken", When: "live &&"}}); err == nil {
+		t.Error("New accepted an alert that doesn't parse")
+	}
+}
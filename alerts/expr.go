@@ -0,0 +1,398 @@
+package alerts
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// The rule language is a small expression grammar over integer and
+// boolean game facts:
+//
+//	or      = and { ("||" | "or") and }
+//	and     = not { ("&&" | "and") not }
+//	not     = ("!" | "not") not | compare
+//	compare = sum [ ("<" | "<=" | ">" | ">=" | "==" | "!=") sum ]
+//	sum     = unary { ("+" | "-") unary }
+//	unary   = "-" unary | primary
+//	primary = number | "true" | "false" | variable | "(" or ")"
+//
+// Expressions are type checked when compiled, so a rule that compiles
+// cannot fail when evaluated.
+
+// kind is the static type of an expression.
+type kind int
+
+const (
+	intKind kind = iota
+	boolKind
+)
+
+func (k kind) String() string {
+	if k == boolKind {
+		return "boolean"
+	}
+	return "number"
+}
+
+// expr is a compiled expression. Booleans evaluate to 0 or 1.
+type expr interface {
+	kind() kind
+	eval(f Facts) int
+}
+
+type literal struct {
+	k     kind
+	value int
+}
+
+func (l literal) kind() kind     { return l.k }
+func (l literal) eval(Facts) int { return l.value }
+
+// variable is a game fact; the variables are listed in alerts.go.
+type variable struct {
+	k   kind
+	get func(Facts) int
+}
+
+func (v variable) kind() kind       { return v.k }
+func (v variable) eval(f Facts) int { return v.get(f) }
+
+type unary struct {
+	op      string
+	operand expr
+}
+
+func (u unary) kind() kind {
+	if u.op == "-" {
+		return intKind
+	}
+	return boolKind
+}
+
+func (u unary) eval(f Facts) int {
+	v := u.operand.eval(f)
+	if u.op == "-" {
+		return -v
+	}
+	return boolInt(v == 0)
+}
+
+type binary struct {
+	op          string
+	left, right expr
+}
+
+func (b binary) kind() kind {
+	if b.op == "+" || b.op == "-" {
+		return intKind
+	}
+	return boolKind
+}
+
+func (b binary) eval(f Facts) int {
+	l := b.left.eval(f)
+	// Short-circuit the logical operators
+	switch b.op {
+	case "&&":
+		return boolInt(l != 0 && b.right.eval(f) != 0)
+	case "||":
+		return boolInt(l != 0 || b.right.eval(f) != 0)
+	}
+
+	r := b.right.eval(f)
+	switch b.op {
+	case "+":
+		return l + r
+	case "-":
+		return l - r
+	case "<":
+		return boolInt(l < r)
+	case "<=":
+		return boolInt(l <= r)
+	case ">":
+		return boolInt(l > r)
+	case ">=":
+		return boolInt(l >= r)
+	case "==":
+		return boolInt(l == r)
+	case "!=":
+		return boolInt(l != r)
+	}
+	return 0
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// token is a lexical token; pos is its zero-based byte offset.
+type token struct {
+	text string
+	pos  int
+}
+
+// Keyword spellings of the logical operators.
+var keywords = map[string]string{"and": "&&", "or": "||", "not": "!"}
+
+func tokenize(src string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(src); {
+		c := rune(src[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case unicode.IsDigit(c):
+			start := i
+			for i < len(src) && unicode.IsDigit(rune(src[i])) {
+				i++
+			}
+			tokens = append(tokens, token{src[start:i], start})
+		case c == '_' || unicode.IsLetter(c):
+			start := i
+			for i < len(src) && (src[i] == '_' || unicode.IsLetter(rune(src[i])) || unicode.IsDigit(rune(src[i]))) {
+				i++
+			}
+			word := strings.ToLower(src[start:i])
+			if op, ok := keywords[word]; ok {
+				word = op
+			}
+			tokens = append(tokens, token{word, start})
+		default:
+			if i+1 < len(src) {
+				switch two := src[i : i+2]; two {
+				case "&&", "||", "<=", ">=", "==", "!=":
+					tokens = append(tokens, token{two, i})
+					i += 2
+					continue
+				}
+			}
+			if !strings.ContainsRune("<>!+-()", c) {
+				return nil, errorAt(i, "unexpected %q", c)
+			}
+			tokens = append(tokens, token{string(c), i})
+			i++
+		}
+	}
+	return tokens, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	// end is the length of the source, for errors at the end of input.
+	end int
+}
+
+// parse compiles src into a boolean expression.
+func parse(src string) (expr, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty expression")
+	}
+
+	p := &parser{tokens: tokens, end: len(src)}
+	e, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, p.errorf("unexpected %q", p.tokens[p.pos].text)
+	}
+	if e.kind() != boolKind {
+		return nil, fmt.Errorf("expression is a number, not a condition")
+	}
+	return e, nil
+}
+
+func (p *parser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos].text
+	}
+	return ""
+}
+
+// errorf reports an error at the next token, or at the end of input.
+func (p *parser) errorf(format string, args ...interface{}) error {
+	if p.pos < len(p.tokens) {
+		return errorAt(p.tokens[p.pos].pos, format, args...)
+	}
+	return errorAt(p.end, format, args...)
+}
+
+// errorAt reports an error at a zero-based byte offset.
+func errorAt(pos int, format string, args ...interface{}) error {
+	return fmt.Errorf("%s at column %d", fmt.Sprintf(format, args...), pos+1)
+}
+
+// next consumes the next token, an operator the caller peeked at.
+func (p *parser) next() token {
+	p.pos++
+	return p.tokens[p.pos-1]
+}
+
+// operands checks that both sides of a binary operator have the kind it
+// needs.
+func (p *parser) operands(op token, want kind, left, right expr) error {
+	if left.kind() != want || right.kind() != want {
+		return errorAt(op.pos, "%q needs %s operands", op.text, want)
+	}
+	return nil
+}
+
+func (p *parser) or() (expr, error) {
+	left, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "||" {
+		op := p.next()
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.operands(op, boolKind, left, right); err != nil {
+			return nil, err
+		}
+		left = binary{"||", left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) and() (expr, error) {
+	left, err := p.not()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "&&" {
+		op := p.next()
+		right, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.operands(op, boolKind, left, right); err != nil {
+			return nil, err
+		}
+		left = binary{"&&", left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) not() (expr, error) {
+	if p.peek() != "!" {
+		return p.compare()
+	}
+	op := p.next()
+	operand, err := p.not()
+	if err != nil {
+		return nil, err
+	}
+	if operand.kind() != boolKind {
+		return nil, errorAt(op.pos, `"!" needs a boolean operand`)
+	}
+	return unary{"!", operand}, nil
+}
+
+func (p *parser) compare() (expr, error) {
+	left, err := p.sum()
+	if err != nil {
+		return nil, err
+	}
+	switch p.peek() {
+	case "<", "<=", ">", ">=", "==", "!=":
+		op := p.next()
+		right, err := p.sum()
+		if err != nil {
+			return nil, err
+		}
+		if op.text == "==" || op.text == "!=" {
+			if left.kind() != right.kind() {
+				return nil, errorAt(op.pos, "%q compares a %s with a %s", op.text, left.kind(), right.kind())
+			}
+		} else if err := p.operands(op, intKind, left, right); err != nil {
+			return nil, err
+		}
+		return binary{op.text, left, right}, nil
+	}
+	return left, nil
+}
+
+func (p *parser) sum() (expr, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "+" || p.peek() == "-" {
+		op := p.next()
+		right, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.operands(op, intKind, left, right); err != nil {
+			return nil, err
+		}
+		left = binary{op.text, left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) unary() (expr, error) {
+	if p.peek() != "-" {
+		return p.primary()
+	}
+	op := p.next()
+	operand, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	if operand.kind() != intKind {
+		return nil, errorAt(op.pos, `"-" needs a number operand`)
+	}
+	return unary{"-", operand}, nil
+}
+
+func (p *parser) primary() (expr, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, p.errorf("unexpected end of expression")
+	}
+	text := p.tokens[p.pos].text
+
+	switch {
+	case text == "(":
+		p.pos++
+		e, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, p.errorf("missing )")
+		}
+		p.pos++
+		return e, nil
+	case text == "true" || text == "false":
+		p.pos++
+		return literal{boolKind, boolInt(text == "true")}, nil
+	case unicode.IsDigit(rune(text[0])):
+		n, err := strconv.Atoi(text)
+		if err != nil {
+			return nil, p.errorf("bad number %q", text)
+		}
+		p.pos++
+		return literal{intKind, n}, nil
+	case text[0] == '_' || unicode.IsLetter(rune(text[0])):
+		v, ok := variables[text]
+		if !ok {
+			return nil, p.errorf("unknown variable %q", text)
+		}
+		p.pos++
+		return v, nil
+	}
+	return nil, p.errorf("unexpected %q", text)
+}
@@ -0,0 +1,80 @@
+package alerts
+
+import "testing"
+
+func TestParseEval(t *testing.T) {
+	facts := Facts{Sport: "basketball", Away: 98, Home: 101, Period: 4, Regulation: 4, State: "in"}
+	tests := []struct {
+		src  string
+		want bool
+	}{
+		// && binds tighter than ||, and ! tighter than &&
+		{"true || false && false", true},
+		{"(true || false) && false", false},
+		{"!false && false", false},
+		{"!(false && false)", true},
+		{"false || !true || true", true},
+
+		// Keywords, in any case, spell the logical operators
+		{"true or false and false", true},
+		{"not false and false", false},
+		{"NOT final AND live", true},
+		{"live And Not tied", true},
+
+		// Arithmetic binds tighter than comparison
+		{"1 + 2 < 4", true},
+		{"-1 + 2 == 1", true},
+		{"home - away == 3", true},
+		{"--3 == 3", true},
+		{"total >= 199 && margin <= close_margin", true},
+		{"period == periods", true},
+		{"live == true", true},
+		{"final != false", false},
+	}
+	for _, tt := range tests {
+		e, err := parse(tt.src)
+		if err != nil {
+			t.Errorf("parse(%q): %v", tt.src, err)
+			continue
+		}
+		if got := e.eval(facts) != 0; got != tt.want {
+			t.Errorf("%q = %v, want %v", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		// Type errors point at the operator
+		{"margin && live", `"&&" needs boolean operands at column 8`},
+		{"live and margin", `"&&" needs boolean operands at column 6`},
+		{"live || 3", `"||" needs boolean operands at column 6`},
+		{"live < 3", `"<" needs number operands at column 6`},
+		{"margin + live > 2", `"+" needs number operands at column 8`},
+		{"margin == live", `"==" compares a number with a boolean at column 8`},
+		{"!margin", `"!" needs a boolean operand at column 1`},
+		{"live && -final", `"-" needs a number operand at column 9`},
+		{"margin + 2", "expression is a number, not a condition"},
+
+		// Syntax errors point at the token, or past the end
+		{"live &&", "unexpected end of expression at column 8"},
+		{"(live", "missing ) at column 6"},
+		{"live final", `unexpected "final" at column 6`},
+		{"margin <= closeness", `unknown variable "closeness" at column 11`},
+		{"margin = 3", `unexpected '=' at column 8`},
+		{"live & late", `unexpected '&' at column 6`},
+		{")", `unexpected ")" at column 1`},
+		{"   ", "empty expression"},
+	}
+	for _, tt := range tests {
+		_, err := parse(tt.src)
+		if err == nil {
+			t.Errorf("parse(%q) succeeded, want %s", tt.src, tt.want)
+		} else if err.Error() != tt.want {
+			t.Errorf("parse(%q) = %s, want %s", tt.src, err, tt.want)
+		}
+	}
+}
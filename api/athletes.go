@@ -0,0 +1,190 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	// commonPath is the ESPN common API prefix, relative to Client.BaseURL.
+	// Athlete profiles are only served there.
+	commonPath = "/apis/common/v3/sports"
+)
+
+// Athlete is a player as listed on a team roster.
+type Athlete struct {
+	ID           string
+	Name         string
+	ShortName    string
+	Jersey       string
+	Position     string
+	PositionName string
+	Age          int
+	Height       string
+	Weight       string
+	Experience   string
+	College      string
+	BirthPlace   string
+}
+
+// AthleteProfile is a player's bio together with their season statistics.
+type AthleteProfile struct {
+	Athlete
+	Team        string
+	DateOfBirth string
+	StatsTitle  string
+	Stats       []Statistic
+}
+
+type espnAthlete struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	FullName    string `json:"fullName"`
+	ShortName   string `json:"shortName"`
+	Jersey      string `json:"jersey"`
+	Position    struct {
+		Abbreviation string `json:"abbreviation"`
+		DisplayName  string `json:"displayName"`
+	} `json:"position"`
+	Age           int    `json:"age"`
+	DisplayHeight string `json:"displayHeight"`
+	DisplayWeight string `json:"displayWeight"`
+	Experience    struct {
+		Years int `json:"years"`
+	} `json:"experience"`
+	DisplayExperience string `json:"displayExperience"`
+	College           struct {
+		Name string `json:"name"`
+	} `json:"college"`
+	BirthPlace struct {
+		City    string `json:"city"`
+		State   string `json:"state"`
+		Country string `json:"country"`
+	} `json:"birthPlace"`
+	DisplayBirthPlace string `json:"displayBirthPlace"`
+}
+
+func (a espnAthlete) toAthlete() Athlete {
+	athlete := Athlete{
+		ID:           a.ID,
+		Name:         a.DisplayName,
+		ShortName:    a.ShortName,
+		Jersey:       a.Jersey,
+		Position:     a.Position.Abbreviation,
+		PositionName: a.Position.DisplayName,
+		Age:          a.Age,
+		Height:       a.DisplayHeight,
+		Weight:       a.DisplayWeight,
+		Experience:   a.DisplayExperience,
+		College:      a.College.Name,
+		BirthPlace:   a.DisplayBirthPlace,
+	}
+	if athlete.Name == "" {
+		athlete.Name = a.FullName
+	}
+	if athlete.Experience == "" && a.Experience.Years > 0 {
+		athlete.Experience = fmt.Sprintf("%d yrs", a.Experience.Years)
+	}
+	if athlete.BirthPlace == "" {
+		var parts []string
+		for _, part := range []string{a.BirthPlace.City, a.BirthPlace.State, a.BirthPlace.Country} {
+			if part != "" {
+				parts = append(parts, part)
+			}
+		}
+		athlete.BirthPlace = strings.Join(parts, ", ")
+	}
+	return athlete
+}
+
+// Roster fetches a team's roster from ESPN.
+func (p *ESPNProvider) Roster(ctx context.Context, sport string, league string, teamID string) ([]Athlete, error) {
+	path := fmt.Sprintf("%s/%s/%s/teams/%s/roster", sitePath, sport, league, teamID)
+
+	body, stale, err := p.client.get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch roster: %w", err)
+	}
+
+	var resp struct {
+		Athletes []json.RawMessage `json:"athletes"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, parseError(err)
+	}
+
+	// Basketball rosters are a flat list of athletes; football, baseball and
+	// hockey group them by position ({"position": "offense", "items": [...]}).
+	var athletes []Athlete
+	for _, raw := range resp.Athletes {
+		var group struct {
+			Items []espnAthlete `json:"items"`
+		}
+		if err := json.Unmarshal(raw, &group); err == nil && len(group.Items) > 0 {
+			for _, a := range group.Items {
+				athletes = append(athletes, a.toAthlete())
+			}
+			continue
+		}
+
+		var a espnAthlete
+		if err := json.Unmarshal(raw, &a); err != nil {
+			return nil, parseError(fmt.Errorf("athlete: %w", err))
+		}
+		athletes = append(athletes, a.toAthlete())
+	}
+
+	return athletes, stale
+}
+
+// Athlete fetches a player's profile and season statistics from ESPN.
+func (p *ESPNProvider) Athlete(ctx context.Context, sport string, league string, athleteID string) (*AthleteProfile, error) {
+	path := fmt.Sprintf("%s/%s/%s/athletes/%s", commonPath, sport, league, athleteID)
+
+	body, stale, err := p.client.get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch athlete: %w", err)
+	}
+
+	var resp struct {
+		Athlete struct {
+			espnAthlete
+			DisplayDOB string `json:"displayDOB"`
+			Team       struct {
+				DisplayName string `json:"displayName"`
+			} `json:"team"`
+			StatsSummary struct {
+				DisplayName string `json:"displayName"`
+				Statistics  []struct {
+					DisplayName      string `json:"displayName"`
+					ShortDisplayName string `json:"shortDisplayName"`
+					DisplayValue     string `json:"displayValue"`
+					RankDisplayValue string `json:"rankDisplayValue"`
+				} `json:"statistics"`
+			} `json:"statsSummary"`
+		} `json:"athlete"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, parseError(err)
+	}
+
+	a := resp.Athlete
+	profile := &AthleteProfile{
+		Athlete:     a.toAthlete(),
+		Team:        a.Team.DisplayName,
+		DateOfBirth: a.DisplayDOB,
+		StatsTitle:  a.StatsSummary.DisplayName,
+	}
+
+	for _, stat := range a.StatsSummary.Statistics {
+		value := stat.DisplayValue
+		if stat.RankDisplayValue != "" {
+			value += " (" + stat.RankDisplayValue + ")"
+		}
+		profile.Stats = append(profile.Stats, Statistic{Label: stat.DisplayName, Value: value})
+	}
+
+	return profile, stale
+}
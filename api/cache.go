@@ -0,0 +1,334 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache keeps API responses in memory and, when Dir is set, on disk, keyed
+// by URL. A fresh entry is served without a request. Once its TTL has
+// passed it is served stale for as long again while a background request
+// revalidates it; after that the next request waits for the revalidation.
+// Live responses are never served stale, since a refresh showing the
+// previous score would defeat the point. Revalidation sends the entry's
+// ETag and Last-Modified, so an unchanged response costs a 304.
+//
+// Entries outlive their TTL, so the cache doubles as the offline store:
+// when a request fails, the last good response is served instead. Memory
+// holds at most MaxEntries of them, dropping the least recently used,
+// and Prune clears out the disk copies older than MaxAge.
+type Cache struct {
+	// Dir persists entries across runs. Empty keeps them in memory only.
+	Dir string
+
+	// MaxEntries bounds the entries held in memory. Zero means no bound.
+	// Entries dropped from memory are read back from Dir when needed.
+	MaxEntries int
+
+	// MaxAge is how long after it was fetched Prune keeps an entry on
+	// disk. Zero keeps entries forever.
+	MaxAge time.Duration
+
+	// TTL returns how long a response stays fresh. DefaultTTL is used
+	// when nil.
+	TTL func(path string, body []byte) time.Duration
+
+	// Offline serves every request from the cache, however old, without
+	// touching the network.
+	Offline bool
+
+	mu           sync.Mutex
+	entries      map[string]*cacheEntry
+	revalidating map[string]bool
+}
+
+// cacheEntry is a cached response, also its on-disk JSON form.
+type cacheEntry struct {
+	URL          string    `json:"url"`
+	Body         []byte    `json:"body"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	Fetched      time.Time `json:"fetched"`
+	Expires      time.Time `json:"expires"`
+
+	// StaleUntil is when the entry stops being served while it is
+	// revalidated in the background.
+	StaleUntil time.Time `json:"stale_until"`
+
+	// used is when the entry was last looked up or stored.
+	used time.Time
+}
+
+// Default bounds of NewCache.
+const (
+	DefaultCacheEntries = 256
+	DefaultCacheMaxAge  = 7 * 24 * time.Hour
+)
+
+// NewCache returns a cache persisting to dir, or memory only when dir is
+// empty, with the default bounds.
+func NewCache(dir string) *Cache {
+	return &Cache{Dir: dir, MaxEntries: DefaultCacheEntries, MaxAge: DefaultCacheMaxAge}
+}
+
+// DefaultCacheDir returns the platform's user cache directory for
+// sportsterminal, e.g. ~/.cache/sportsterminal/http.
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "sportsterminal", "http"), nil
+}
+
+// Cache lifetimes by what a response shows.
+const (
+	// PreGameTTL applies to scoreboards and summaries of games yet to
+	// start, which may start at any moment.
+	PreGameTTL = 2 * time.Minute
+
+	// FinalTTL applies once every game in a response is over.
+	FinalTTL = 6 * time.Hour
+
+	// OtherTTL applies to standings, teams, rosters and athletes.
+	OtherTTL = 10 * time.Minute
+)
+
+// LiveTTL is how long a response showing a game in progress stays fresh,
+// by sport: scores move faster in some sports than others.
+var LiveTTL = map[string]time.Duration{
+	"basketball": 10 * time.Second,
+	"football":   15 * time.Second,
+	"hockey":     15 * time.Second,
+	"baseball":   20 * time.Second,
+	"soccer":     20 * time.Second,
+}
+
+// defaultLiveTTL applies to live games in sports missing from LiveTTL.
+const defaultLiveTTL = 15 * time.Second
+
+var stateRe = regexp.MustCompile(`"state"\s*:\s*"(pre|in|post)"`)
+
+// DefaultTTL picks a lifetime from the game states in a scoreboard or
+// summary response: short while any game is live, longer before games
+// start, and long once they are all final. Other responses get OtherTTL.
+func DefaultTTL(path string, body []byte) time.Duration {
+	var pre, post bool
+	for _, match := range stateRe.FindAllSubmatch(body, -1) {
+		switch string(match[1]) {
+		case "in":
+			if ttl, ok := LiveTTL[pathSport(path)]; ok {
+				return ttl
+			}
+			return defaultLiveTTL
+		case "pre":
+			pre = true
+		case "post":
+			post = true
+		}
+	}
+
+	switch {
+	case pre:
+		return PreGameTTL
+	case post:
+		return FinalTTL
+	}
+	return OtherTTL
+}
+
+// pathSport returns the sport segment of an API path such as
+// /apis/site/v2/sports/basketball/nba/scoreboard.
+func pathSport(path string) string {
+	_, rest, ok := strings.Cut(path, "/sports/")
+	if !ok {
+		return ""
+	}
+	sport, _, _ := strings.Cut(rest, "/")
+	return sport
+}
+
+func (c *Cache) ttl(path string, body []byte) time.Duration {
+	if c.TTL != nil {
+		return c.TTL(path, body)
+	}
+	return DefaultTTL(path, body)
+}
+
+// staleFor returns how long past its TTL an entry may be served stale:
+// as long again as the TTL, except for live responses.
+func staleFor(ttl time.Duration) time.Duration {
+	if ttl < PreGameTTL {
+		return 0
+	}
+	return ttl
+}
+
+// lookup returns the entry for url from memory, or from disk on a miss.
+func (c *Cache) lookup(url string) (*cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry, ok := c.entries[url]; ok {
+		entry.used = time.Now()
+		return entry, true
+	}
+	if c.Dir == "" {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(c.file(url))
+	if err != nil {
+		return nil, false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.URL != url {
+		return nil, false
+	}
+	c.remember(&entry)
+	return &entry, true
+}
+
+// store records a response fetched for path and returns its entry.
+func (c *Cache) store(url, path string, body []byte, etag, lastModified string) *cacheEntry {
+	now := time.Now()
+	ttl := c.ttl(path, body)
+	entry := &cacheEntry{
+		URL:          url,
+		Body:         body,
+		ETag:         etag,
+		LastModified: lastModified,
+		Fetched:      now,
+		Expires:      now.Add(ttl),
+		StaleUntil:   now.Add(ttl + staleFor(ttl)),
+	}
+
+	c.mu.Lock()
+	c.remember(entry)
+	c.mu.Unlock()
+
+	if c.Dir != "" {
+		// The disk copy only saves requests, so failing to write it is
+		// not worth failing the request over
+		_ = c.save(entry)
+	}
+	return entry
+}
+
+// remember keeps entry in memory, making room for it if needed.
+func (c *Cache) remember(entry *cacheEntry) {
+	if c.entries == nil {
+		c.entries = map[string]*cacheEntry{}
+	}
+	if _, ok := c.entries[entry.URL]; !ok && c.MaxEntries > 0 && len(c.entries) >= c.MaxEntries {
+		c.evict()
+	}
+	entry.used = time.Now()
+	c.entries[entry.URL] = entry
+}
+
+// evict drops the least recently used entry from memory.
+func (c *Cache) evict() {
+	var oldest *cacheEntry
+	for _, entry := range c.entries {
+		if oldest == nil || entry.used.Before(oldest.used) {
+			oldest = entry
+		}
+	}
+	if oldest != nil {
+		delete(c.entries, oldest.URL)
+	}
+}
+
+// Prune deletes the entries on disk fetched more than MaxAge ago, judging
+// by when their files were written, along with any temporary files left
+// behind by an interrupted save.
+func (c *Cache) Prune() error {
+	if c.Dir == "" || c.MaxAge <= 0 {
+		return nil
+	}
+	files, err := os.ReadDir(c.Dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	cutoff := time.Now().Add(-c.MaxAge)
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasSuffix(name, ".json") && !strings.HasPrefix(name, ".entry-") {
+			continue
+		}
+		info, err := file.Info()
+		if err != nil || !info.ModTime().Before(cutoff) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(c.Dir, name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// save writes an entry to disk, replacing any previous copy atomically.
+func (c *Cache) save(entry *cacheEntry) error {
+	if err := os.MkdirAll(c.Dir, 0o755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(c.Dir, ".entry-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), c.file(entry.URL))
+}
+
+// file returns the on-disk location of url's entry.
+func (c *Cache) file(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(c.Dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// startRevalidation claims the background revalidation of url, reporting
+// false when one is already running.
+func (c *Cache) startRevalidation(url string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.revalidating[url] {
+		return false
+	}
+	if c.revalidating == nil {
+		c.revalidating = map[string]bool{}
+	}
+	c.revalidating[url] = true
+	return true
+}
+
+func (c *Cache) finishRevalidation(url string) {
+	c.mu.Lock()
+	delete(c.revalidating, url)
+	c.mu.Unlock()
+}
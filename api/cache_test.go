@@ -0,0 +1,229 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDefaultTTL(t *testing.T) {
+	const scoreboard = "/apis/site/v2/sports/basketball/nba/scoreboard"
+	tests := []struct {
+		name string
+		path string
+		body string
+		want time.Duration
+	}{
+		{"live basketball", scoreboard, `{"state":"post"},{"state": "in"}`, 10 * time.Second},
+		{"live baseball", "/apis/site/v2/sports/baseball/mlb/summary", `{"state":"in"}`, 20 * time.Second},
+		{"live unknown sport", "/apis/site/v2/sports/lacrosse/pll/scoreboard", `{"state":"in"}`, defaultLiveTTL},
+		{"before and after", scoreboard, `{"state":"post"},{"state":"pre"}`, PreGameTTL},
+		{"all final", scoreboard, `{"state":"post"},{"state":"post"}`, FinalTTL},
+		{"standings", "/apis/v2/sports/basketball/nba/standings", `{"standings":{}}`, OtherTTL},
+	}
+	for _, tt := range tests {
+		if got := DefaultTTL(tt.path, []byte(tt.body)); got != tt.want {
+			t.Errorf("%s: DefaultTTL = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// testServer serves body with etag, answering a matching If-None-Match
+// with 304, and counts requests by outcome.
+type testServer struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	body        string
+	etag        string
+	status      int
+	full        int
+	notModified int
+}
+
+func newTestServer(t *testing.T, body, etag string) *testServer {
+	s := &testServer{body: body, etag: etag, status: http.StatusOK}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.status != http.StatusOK {
+			w.WriteHeader(s.status)
+			return
+		}
+		if r.Header.Get("If-None-Match") == s.etag {
+			s.notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		s.full++
+		w.Header().Set("ETag", s.etag)
+		w.Write([]byte(s.body))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *testServer) set(body, etag string, status int) {
+	s.mu.Lock()
+	s.body, s.etag, s.status = body, etag, status
+	s.mu.Unlock()
+}
+
+func (s *testServer) counts() (full, notModified int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.full, s.notModified
+}
+
+func newTestClient(s *testServer, ttl time.Duration) *Client {
+	cache := NewCache("")
+	cache.TTL = func(string, []byte) time.Duration { return ttl }
+	return &Client{BaseURL: s.URL, HTTPClient: s.Client(), Cache: cache}
+}
+
+func getBody(t *testing.T, c *Client) (string, error) {
+	t.Helper()
+	body, stale, err := c.get(context.Background(), "/scoreboard")
+	if err != nil {
+		return "", err
+	}
+	return string(body), stale
+}
+
+func TestClientRevalidates(t *testing.T) {
+	s := newTestServer(t, "v1", `"1"`)
+	// Live TTLs are never served stale, so every expired get revalidates
+	c := newTestClient(s, 0)
+
+	for i := 0; i < 3; i++ {
+		body, stale := getBody(t, c)
+		if body != "v1" || stale != nil {
+			t.Fatalf("get %d = %q, %v; want v1", i, body, stale)
+		}
+	}
+	if full, notModified := s.counts(); full != 1 || notModified != 2 {
+		t.Errorf("server sent %d full and %d 304 responses, want 1 and 2", full, notModified)
+	}
+
+	s.set("v2", `"2"`, http.StatusOK)
+	if body, _ := getBody(t, c); body != "v2" {
+		t.Errorf("get after a change = %q, want v2", body)
+	}
+}
+
+func TestClientServesFresh(t *testing.T) {
+	s := newTestServer(t, "v1", `"1"`)
+	c := newTestClient(s, time.Minute)
+
+	getBody(t, c)
+	s.set("v2", `"2"`, http.StatusOK)
+	if body, _ := getBody(t, c); body != "v1" {
+		t.Errorf("fresh get = %q, want v1 from the cache", body)
+	}
+	if full, notModified := s.counts(); full != 1 || notModified != 0 {
+		t.Errorf("server sent %d full and %d 304 responses, want 1 and 0", full, notModified)
+	}
+}
+
+func TestClientStaleWhileRevalidate(t *testing.T) {
+	s := newTestServer(t, "v1", `"1"`)
+	c := newTestClient(s, FinalTTL)
+	getBody(t, c)
+
+	// Past its TTL but within the stale window
+	entry, _ := c.Cache.lookup(s.URL + "/scoreboard")
+	entry.Expires = time.Now().Add(-time.Second)
+	s.set("v2", `"2"`, http.StatusOK)
+
+	if body, stale := getBody(t, c); body != "v1" || stale != nil {
+		t.Errorf("stale get = %q, %v; want v1 served while revalidating", body, stale)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if entry, _ := c.Cache.lookup(s.URL + "/scoreboard"); string(entry.Body) == "v2" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("background revalidation never stored v2")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if body, _ := getBody(t, c); body != "v2" {
+		t.Errorf("get after revalidation = %q, want v2", body)
+	}
+
+	// Past the stale window, the get waits for the response
+	entry, _ = c.Cache.lookup(s.URL + "/scoreboard")
+	entry.Expires = time.Now().Add(-2 * time.Second)
+	entry.StaleUntil = time.Now().Add(-time.Second)
+	s.set("v3", `"3"`, http.StatusOK)
+	if body, _ := getBody(t, c); body != "v3" {
+		t.Errorf("get past the stale window = %q, want v3", body)
+	}
+}
+
+func TestClientFallsBack(t *testing.T) {
+	s := newTestServer(t, "v1", `"1"`)
+	c := newTestClient(s, 0)
+	getBody(t, c)
+
+	s.set("", "", http.StatusServiceUnavailable)
+	body, stale := getBody(t, c)
+	var staleErr *StaleError
+	if body != "v1" || !errors.As(stale, &staleErr) {
+		t.Errorf("get during an outage = %q, %v; want v1, stale", body, stale)
+	}
+
+	s.set("", "", http.StatusNotFound)
+	var apiErr *Error
+	if _, err := getBody(t, c); !errors.As(err, &apiErr) || apiErr.Kind != KindClient {
+		t.Errorf("get after a 404 = %v, want a client error", err)
+	}
+}
+
+func TestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewCache("")
+	c.MaxEntries = 2
+	c.store("a", "/a", []byte("a"), "", "")
+	c.store("b", "/b", []byte("b"), "", "")
+	time.Sleep(time.Millisecond)
+	c.lookup("a")
+	c.store("c", "/c", []byte("c"), "", "")
+
+	for url, want := range map[string]bool{"a": true, "b": false, "c": true} {
+		if _, ok := c.lookup(url); ok != want {
+			t.Errorf("lookup(%q) found = %v, want %v", url, ok, want)
+		}
+	}
+}
+
+func TestCachePrune(t *testing.T) {
+	c := NewCache(t.TempDir())
+	c.store("old", "/old", []byte("old"), "", "")
+	c.store("new", "/new", []byte("new"), "", "")
+	leftover := filepath.Join(c.Dir, ".entry-123")
+	if err := os.WriteFile(leftover, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	past := time.Now().Add(-c.MaxAge - time.Hour)
+	for _, name := range []string{c.file("old"), leftover} {
+		if err := os.Chtimes(name, past, past); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := c.Prune(); err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]bool{c.file("old"): false, leftover: false, c.file("new"): true} {
+		if _, err := os.Stat(name); (err == nil) != want {
+			t.Errorf("%s kept = %v, want %v", filepath.Base(name), err == nil, want)
+		}
+	}
+}
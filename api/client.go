@@ -0,0 +1,285 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultBaseURL is the root of ESPN's public site API. Paths such as
+	// /apis/site/v2/sports are appended to it, so a caching proxy or a test
+	// server only needs to mirror the path layout.
+	DefaultBaseURL = "https://site.api.espn.com"
+
+	// DefaultUserAgent identifies requests made by sportsterminal.
+	DefaultUserAgent = "sportsterminal"
+
+	// DefaultTimeout bounds a single API request.
+	DefaultTimeout = 10 * time.Second
+
+	// DefaultRetries, DefaultRetryBackoff and DefaultMaxRetryWait are the
+	// retry settings of NewClient.
+	DefaultRetries      = 2
+	DefaultRetryBackoff = 500 * time.Millisecond
+	DefaultMaxRetryWait = 10 * time.Second
+)
+
+// Environment variables read by NewClientFromEnv.
+const (
+	EnvBaseURL   = "SPORTSTERMINAL_API_BASE"
+	EnvUserAgent = "SPORTSTERMINAL_USER_AGENT"
+	EnvTimeout   = "SPORTSTERMINAL_TIMEOUT"
+	EnvRetries   = "SPORTSTERMINAL_RETRIES"
+)
+
+// Client performs HTTP requests against the ESPN API.
+type Client struct {
+	// BaseURL is the scheme and host requests are sent to, without a
+	// trailing slash.
+	BaseURL string
+
+	// HTTPClient sends the requests. http.DefaultClient is used when nil.
+	HTTPClient *http.Client
+
+	// UserAgent is sent with every request when non-empty.
+	UserAgent string
+
+	// Timeout bounds each request. Zero means no timeout beyond whatever
+	// HTTPClient enforces.
+	Timeout time.Duration
+
+	// Cache, when set, serves repeated requests from earlier responses.
+	Cache *Cache
+
+	// Retries is how many times a request failing with a network error,
+	// timeout, 429 or 5xx is retried.
+	Retries int
+
+	// RetryBackoff is the wait before the first retry, doubling for each
+	// one after.
+	RetryBackoff time.Duration
+
+	// MaxRetryWait caps the wait before a retry. A server asking for a
+	// longer wait gets the error returned instead.
+	MaxRetryWait time.Duration
+}
+
+// DefaultClient is used by DefaultProvider and the package-level helpers.
+var DefaultClient = NewClient()
+
+// NewClient returns a Client talking directly to ESPN.
+func NewClient() *Client {
+	return &Client{
+		BaseURL:      DefaultBaseURL,
+		HTTPClient:   &http.Client{},
+		UserAgent:    DefaultUserAgent,
+		Timeout:      DefaultTimeout,
+		Retries:      DefaultRetries,
+		RetryBackoff: DefaultRetryBackoff,
+		MaxRetryWait: DefaultMaxRetryWait,
+	}
+}
+
+// NewClientFromEnv returns NewClient with any overrides from
+// SPORTSTERMINAL_API_BASE, SPORTSTERMINAL_USER_AGENT,
+// SPORTSTERMINAL_TIMEOUT and SPORTSTERMINAL_RETRIES applied.
+func NewClientFromEnv() (*Client, error) {
+	c := NewClient()
+
+	if base := os.Getenv(EnvBaseURL); base != "" {
+		c.BaseURL = base
+	}
+	if ua := os.Getenv(EnvUserAgent); ua != "" {
+		c.UserAgent = ua
+	}
+	if timeout := os.Getenv(EnvTimeout); timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", EnvTimeout, err)
+		}
+		c.Timeout = d
+	}
+	if retries := os.Getenv(EnvRetries); retries != "" {
+		n, err := strconv.Atoi(retries)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid %s: %q is not a count", EnvRetries, retries)
+		}
+		c.Retries = n
+	}
+
+	return c, nil
+}
+
+// get fetches path relative to BaseURL and returns the response body,
+// going through the cache when there is one. When the request fails with
+// an error that may be temporary, or the cache is offline, a cached body is
+// returned with a *StaleError in stale; err is set when there is no body
+// at all, or when the API rejected the request outright. Cancelling ctx
+// abandons the request, its retries and any fallback.
+func (c *Client) get(ctx context.Context, path string) (body []byte, stale error, err error) {
+	url := strings.TrimRight(c.BaseURL, "/") + path
+	if c.Cache == nil {
+		resp, err := c.fetch(ctx, url, nil)
+		return resp.body, nil, err
+	}
+
+	entry, ok := c.Cache.lookup(url)
+	if c.Cache.Offline {
+		if !ok {
+			return nil, nil, fmt.Errorf("not cached: %w", ErrOffline)
+		}
+		return entry.Body, &StaleError{Since: entry.Fetched, Err: ErrOffline}, nil
+	}
+
+	if ok {
+		now := time.Now()
+		if now.Before(entry.Expires) {
+			return entry.Body, nil, nil
+		}
+		// Serve stale while a background request catches up
+		if now.Before(entry.StaleUntil) {
+			if c.Cache.startRevalidation(url) {
+				// The revalidation outlives this request, so it doesn't
+				// share its context
+				go func() {
+					defer c.Cache.finishRevalidation(url)
+					_, _ = c.revalidate(context.Background(), url, path, entry)
+				}()
+			}
+			return entry.Body, nil, nil
+		}
+	}
+
+	body, err = c.revalidate(ctx, url, path, entry)
+	if err != nil && entry != nil && ctx.Err() == nil {
+		// Fall back to the last good response, unless the API said it is
+		// gone or wrong rather than unreachable
+		if retryable, _ := Retryable(err); retryable {
+			return entry.Body, &StaleError{Since: entry.Fetched, Err: err}, nil
+		}
+	}
+	return body, nil, err
+}
+
+// revalidate fetches url, conditionally when there is a cached entry, and
+// caches the result.
+func (c *Client) revalidate(ctx context.Context, url, path string, entry *cacheEntry) ([]byte, error) {
+	resp, err := c.fetch(ctx, url, entry)
+	if err != nil {
+		return nil, err
+	}
+	if resp.notModified {
+		c.Cache.store(url, path, entry.Body, entry.ETag, entry.LastModified)
+		return entry.Body, nil
+	}
+	c.Cache.store(url, path, resp.body, resp.etag, resp.lastModified)
+	return resp.body, nil
+}
+
+// response is the part of an HTTP response the client keeps.
+type response struct {
+	body         []byte
+	etag         string
+	lastModified string
+	notModified  bool
+}
+
+// fetch sends a GET request for url, retrying failures that may be
+// temporary up to Retries times. Waits grow exponentially from
+// RetryBackoff with jitter, or follow the server's Retry-After when it
+// fits within MaxRetryWait; a longer Retry-After ends the retries.
+func (c *Client) fetch(ctx context.Context, url string, cached *cacheEntry) (response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := c.fetchOnce(ctx, url, cached)
+		retryable, wait := Retryable(err)
+		if !retryable || attempt >= c.Retries {
+			return resp, err
+		}
+		if wait == 0 {
+			wait = backoff(c.RetryBackoff, attempt)
+		}
+		if wait > c.MaxRetryWait {
+			return resp, err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return response{}, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns the wait before retry attempt+1: base doubled per
+// attempt, with the upper half jittered so clients don't retry in step.
+func backoff(base time.Duration, attempt int) time.Duration {
+	d := base << attempt
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// fetchOnce sends a single GET request for url. With a cached entry the
+// request is conditional, and an unchanged resource yields notModified.
+func (c *Client) fetchOnce(ctx context.Context, url string, cached *cacheEntry) (response, error) {
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return response{}, fmt.Errorf("failed to build request: %w", err)
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	if cached != nil {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return response{}, transportError(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		return response{notModified: true}, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return response{}, statusError(resp)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return response{}, transportError(fmt.Errorf("failed to read response: %w", err))
+	}
+
+	return response{
+		body:         body,
+		etag:         resp.Header.Get("ETag"),
+		lastModified: resp.Header.Get("Last-Modified"),
+	}, nil
+}
@@ -0,0 +1,145 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateRange is an inclusive range of calendar days in the location of
+// Start. Only the date part of Start and End is significant.
+type DateRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Day returns the range covering the single calendar day containing t.
+func Day(t time.Time) DateRange {
+	return DateRange{Start: startOfDay(t), End: startOfDay(t)}
+}
+
+// Days returns the range from the day containing start to the day
+// containing end.
+func Days(start, end time.Time) DateRange {
+	if end.Before(start) {
+		start, end = end, start
+	}
+	return DateRange{Start: startOfDay(start), End: startOfDay(end.In(start.Location()))}
+}
+
+// Contains reports whether t falls on one of the days in the range.
+func (r DateRange) Contains(t time.Time) bool {
+	t = t.In(r.Start.Location())
+	return !t.Before(startOfDay(r.Start)) && t.Before(startOfDay(r.End).AddDate(0, 0, 1))
+}
+
+// SingleDay reports whether the range covers exactly one day.
+func (r DateRange) SingleDay() bool {
+	return startOfDay(r.Start).Equal(startOfDay(r.End))
+}
+
+// DaysList returns the start of every day in the range, in order.
+func (r DateRange) DaysList() []time.Time {
+	var days []time.Time
+	for d := startOfDay(r.Start); !d.After(startOfDay(r.End)); d = d.AddDate(0, 0, 1) {
+		days = append(days, d)
+	}
+	return days
+}
+
+// dayParam is the layout of a day in ESPN's dates parameter.
+const dayParam = "20060102"
+
+// queryParam formats the range as ESPN's dates parameter. ESPN buckets
+// games by US Eastern date, so the query is widened by a day on either side
+// and callers filter the results against the local range with Contains.
+func (r DateRange) queryParam() string {
+	start := r.Start.AddDate(0, 0, -1).Format(dayParam)
+	end := r.End.AddDate(0, 0, 1).Format(dayParam)
+	return fmt.Sprintf("%s-%s", start, end)
+}
+
+func (r DateRange) String() string {
+	if r.SingleDay() {
+		return r.Start.Format("Mon Jan 2")
+	}
+	return fmt.Sprintf("%s – %s", r.Start.Format("Mon Jan 2"), r.End.Format("Mon Jan 2"))
+}
+
+// Window describes how many days around a reference day a scoreboard
+// query covers.
+type Window struct {
+	Lookbehind int
+	Lookahead  int
+}
+
+// DefaultWindow is the range used for the upcoming games listing.
+var DefaultWindow = Window{Lookbehind: 0, Lookahead: 7}
+
+// Range returns the window's date range around the day containing t.
+func (w Window) Range(t time.Time) DateRange {
+	return Days(t.AddDate(0, 0, -w.Lookbehind), t.AddDate(0, 0, w.Lookahead))
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// dayLayouts are the absolute date formats accepted by ParseDay. Layouts
+// without a year resolve to the current year.
+var dayLayouts = []string{
+	"2006-01-02",
+	"20060102",
+	"01/02/2006",
+	"1/2/2006",
+	"01/02",
+	"1/2",
+	"Jan 2",
+	"Jan 2 2006",
+}
+
+// ParseDay turns user input into a day. Besides absolute dates it accepts
+// "today", "yesterday", "tomorrow" and day offsets from base such as "+3"
+// or "-1". Days are in base's time zone.
+func ParseDay(input string, base time.Time) (time.Time, error) {
+	input = strings.TrimSpace(input)
+	loc := base.Location()
+
+	switch strings.ToLower(input) {
+	case "", "today":
+		return startOfDay(time.Now().In(loc)), nil
+	case "yesterday":
+		return startOfDay(time.Now().In(loc)).AddDate(0, 0, -1), nil
+	case "tomorrow":
+		return startOfDay(time.Now().In(loc)).AddDate(0, 0, 1), nil
+	}
+
+	if strings.HasPrefix(input, "+") || strings.HasPrefix(input, "-") {
+		days, err := strconv.Atoi(input)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid day offset %q", input)
+		}
+		return base.AddDate(0, 0, days), nil
+	}
+
+	for _, layout := range dayLayouts {
+		t, err := time.ParseInLocation(layout, input, loc)
+		if err != nil {
+			continue
+		}
+		if !strings.Contains(layout, "2006") {
+			// Dates without a year fall in base's year; one that doesn't
+			// exist then, such as 2/29, is rejected rather than rolled
+			// over into March
+			day := time.Date(base.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+			if day.Month() != t.Month() || day.Day() != t.Day() {
+				return time.Time{}, fmt.Errorf("%d has no %s", base.Year(), t.Format("January 2"))
+			}
+			t = day
+		}
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognized date %q (try 2006-01-02, 1/2 or +3)", input)
+}
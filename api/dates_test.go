@@ -0,0 +1,36 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDayWithoutYear(t *testing.T) {
+	tests := []struct {
+		input string
+		year  int
+		want  string // empty when an error is expected
+	}{
+		{"2/29", 2028, "2028-02-29"},
+		{"Feb 29", 2028, "2028-02-29"},
+		{"2/29", 2026, ""},
+		{"Feb 29", 2026, ""},
+		{"3/1", 2026, "2026-03-01"},
+		{"12/31", 2026, "2026-12-31"},
+	}
+	for _, tt := range tests {
+		base := time.Date(tt.year, 6, 1, 0, 0, 0, 0, time.Local)
+		got, err := ParseDay(tt.input, base)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("ParseDay(%q) in %d = %s, want an error", tt.input, tt.year, got.Format("2006-01-02"))
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseDay(%q) in %d: %v", tt.input, tt.year, err)
+		} else if got.Format("2006-01-02") != tt.want {
+			t.Errorf("ParseDay(%q) in %d = %s, want %s", tt.input, tt.year, got.Format("2006-01-02"), tt.want)
+		}
+	}
+}
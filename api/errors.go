@@ -0,0 +1,158 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ErrorKind classifies why an API request failed.
+type ErrorKind int
+
+const (
+	// KindNetwork is a connection failure: DNS, refused, reset.
+	KindNetwork ErrorKind = iota + 1
+
+	// KindTimeout is a request that took longer than Client.Timeout.
+	KindTimeout
+
+	// KindClient is a 4xx response other than 429, such as an unknown
+	// league or event.
+	KindClient
+
+	// KindRateLimited is a 429 response.
+	KindRateLimited
+
+	// KindServer is a 5xx response.
+	KindServer
+
+	// KindParse is a response that couldn't be decoded.
+	KindParse
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case KindNetwork:
+		return "network"
+	case KindTimeout:
+		return "timeout"
+	case KindClient:
+		return "client"
+	case KindRateLimited:
+		return "rate limited"
+	case KindServer:
+		return "server"
+	case KindParse:
+		return "parse"
+	}
+	return "unknown"
+}
+
+// Error is a failed API request. Provider methods wrap it with what they
+// were fetching; use errors.As to get at it.
+type Error struct {
+	Kind ErrorKind
+
+	// StatusCode is the HTTP status for KindClient, KindRateLimited and
+	// KindServer.
+	StatusCode int
+
+	// RetryAfter is how long the server asked clients to wait before
+	// trying again, or zero when it didn't say.
+	RetryAfter time.Duration
+
+	// Err is the underlying error, if any.
+	Err error
+}
+
+func (e *Error) Error() string {
+	switch e.Kind {
+	case KindNetwork:
+		return fmt.Sprintf("network error: %v", e.Err)
+	case KindTimeout:
+		return fmt.Sprintf("request timed out: %v", e.Err)
+	case KindParse:
+		return fmt.Sprintf("failed to parse response: %v", e.Err)
+	}
+	msg := fmt.Sprintf("API returned status code: %d", e.StatusCode)
+	if e.RetryAfter > 0 {
+		msg += fmt.Sprintf(" (retry after %s)", e.RetryAfter)
+	}
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Retryable reports whether the same request might succeed later.
+func (e *Error) Retryable() bool {
+	switch e.Kind {
+	case KindNetwork, KindTimeout, KindRateLimited, KindServer:
+		return true
+	}
+	return false
+}
+
+// Retryable reports whether err is an API error worth retrying, and how
+// long the server asked to wait first (zero when it didn't say).
+func Retryable(err error) (bool, time.Duration) {
+	var apiErr *Error
+	if errors.As(err, &apiErr) && apiErr.Retryable() {
+		return true, apiErr.RetryAfter
+	}
+	return false, 0
+}
+
+func parseError(err error) error {
+	return &Error{Kind: KindParse, Err: err}
+}
+
+// transportError classifies an error from sending a request or reading
+// its body. Cancellation is returned as it is: it is not a failure of the
+// API.
+func transportError(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return err
+	}
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || errors.As(err, &netErr) && netErr.Timeout() {
+		return &Error{Kind: KindTimeout, Err: err}
+	}
+	return &Error{Kind: KindNetwork, Err: err}
+}
+
+// statusError classifies a response with an unexpected status.
+func statusError(resp *http.Response) error {
+	e := &Error{StatusCode: resp.StatusCode, RetryAfter: retryAfter(resp.Header.Get("Retry-After"))}
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		e.Kind = KindRateLimited
+	case resp.StatusCode >= 500:
+		e.Kind = KindServer
+	default:
+		e.Kind = KindClient
+	}
+	return e
+}
+
+// retryAfter parses a Retry-After header, either delay seconds or an HTTP
+// date. It returns zero when the header is missing or malformed.
+func retryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(header); err == nil {
+		if d := time.Until(at); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
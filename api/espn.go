@@ -0,0 +1,290 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// sitePath is the ESPN site API prefix, relative to Client.BaseURL.
+	sitePath = "/apis/site/v2/sports"
+
+	// scoreboardLimit raises ESPN's default page size so multi-day ranges
+	// for large leagues such as college football are not truncated.
+	scoreboardLimit = 500
+)
+
+type ESPNResponse struct {
+	Events []ESPNEvent `json:"events"`
+}
+
+// ESPNEvent is a game as it appears on scoreboards and team schedules.
+type ESPNEvent struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	ShortName    string `json:"shortName"`
+	Date         string `json:"date"`
+	Competitions []struct {
+		ID    string `json:"id"`
+		Venue struct {
+			FullName string `json:"fullName"`
+		} `json:"venue"`
+		Status struct {
+			Period       int    `json:"period"`
+			DisplayClock string `json:"displayClock"`
+			Type         struct {
+				State       string `json:"state"`
+				Completed   bool   `json:"completed"`
+				Description string `json:"description"`
+				ShortDetail string `json:"shortDetail"`
+			} `json:"type"`
+		} `json:"status"`
+		Competitors []struct {
+			ID       string `json:"id"`
+			HomeAway string `json:"homeAway"`
+			Winner   bool   `json:"winner"`
+			Team     struct {
+				ID               string `json:"id"`
+				DisplayName      string `json:"displayName"`
+				ShortDisplayName string `json:"shortDisplayName"`
+				Abbreviation     string `json:"abbreviation"`
+				Logo             string `json:"logo"`
+				Logos            []struct {
+					Href string `json:"href"`
+				} `json:"logos"`
+			} `json:"team"`
+			Score      espnScore `json:"score"`
+			Linescores []struct {
+				Value        float64 `json:"value"`
+				DisplayValue string  `json:"displayValue"`
+			} `json:"linescores"`
+			Hits   *float64 `json:"hits"`
+			Errors *float64 `json:"errors"`
+		} `json:"competitors"`
+		Odds []struct {
+			Details      string  `json:"details"`
+			Spread       float64 `json:"spread"`
+			OverUnder    float64 `json:"overUnder"`
+			HomeTeamOdds struct {
+				Favorite bool `json:"favorite"`
+			} `json:"homeTeamOdds"`
+			AwayTeamOdds struct {
+				Favorite bool `json:"favorite"`
+			} `json:"awayTeamOdds"`
+		} `json:"odds"`
+	} `json:"competitions"`
+}
+
+// espnScore decodes a score that scoreboards send as a string and team
+// schedules send as an object with a displayValue.
+type espnScore string
+
+func (s *espnScore) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		*s = espnScore(str)
+		return nil
+	}
+
+	var obj struct {
+		DisplayValue string `json:"displayValue"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	*s = espnScore(obj.DisplayValue)
+	return nil
+}
+
+// ESPNProvider is the Provider backed by ESPN's public site API.
+type ESPNProvider struct {
+	client *Client
+}
+
+// NewESPNProvider returns a provider sending requests through client, or
+// through DefaultClient when client is nil.
+func NewESPNProvider(client *Client) *ESPNProvider {
+	if client == nil {
+		client = DefaultClient
+	}
+	return &ESPNProvider{client: client}
+}
+
+// Leagues returns the sports and leagues ESPN is queried for.
+func (p *ESPNProvider) Leagues() []Sport {
+	return AvailableSports
+}
+
+// Scoreboard fetches the league scoreboard from ESPN for the given days.
+func (p *ESPNProvider) Scoreboard(ctx context.Context, sport string, league string, dates DateRange) ([]Game, error) {
+	path := fmt.Sprintf("%s/%s/%s/scoreboard?dates=%s&limit=%d", sitePath, sport, league, dates.queryParam(), scoreboardLimit)
+
+	body, stale, err := p.client.get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch games: %w", err)
+	}
+
+	games, err := parseScoreboard(body, dates)
+	if err != nil {
+		return nil, err
+	}
+	return games, stale
+}
+
+// parseScoreboard converts a scoreboard payload into the games played on
+// the given days, sorted by start time.
+func parseScoreboard(body []byte, dates DateRange) ([]Game, error) {
+	var espnResp ESPNResponse
+	if err := json.Unmarshal(body, &espnResp); err != nil {
+		return nil, parseError(err)
+	}
+
+	games := make([]Game, 0, len(espnResp.Events))
+
+	for _, event := range espnResp.Events {
+		game, ok := parseEvent(event)
+		if !ok {
+			continue
+		}
+
+		// Only include games within our date range
+		if !dates.Contains(game.Date) {
+			continue
+		}
+
+		games = append(games, game)
+	}
+
+	// Sort games by date (chronologically)
+	sort.Slice(games, func(i, j int) bool {
+		return games[i].Date.Before(games[j].Date)
+	})
+
+	return games, nil
+}
+
+// parseEvent converts an ESPN event into a Game. It reports false for
+// events without a competition or with an unparseable date.
+func parseEvent(event ESPNEvent) (Game, bool) {
+	if len(event.Competitions) == 0 {
+		return Game{}, false
+	}
+
+	comp := event.Competitions[0]
+
+	var gameDate time.Time
+	if t, err := time.Parse(time.RFC3339, event.Date); err == nil {
+		gameDate = t
+	} else if t, err := time.Parse("2006-01-02T15:04Z", event.Date); err == nil {
+		// ESPN sometimes returns dates without seconds
+		gameDate = t
+	} else {
+		return Game{}, false
+	}
+
+	game := Game{
+		ID:           event.ID,
+		Name:         event.Name,
+		ShortName:    event.ShortName,
+		Status:       comp.Status.Type.Description,
+		StatusDetail: comp.Status.Type.ShortDetail,
+		State:        comp.Status.Type.State,
+		Period:       comp.Status.Period,
+		Clock:        comp.Status.DisplayClock,
+		IsLive:       comp.Status.Type.State == "in",
+		Completed:    comp.Status.Type.Completed,
+		Venue:        comp.Venue.FullName,
+		Date:         gameDate,
+	}
+
+	// Extract team information
+	for _, competitor := range comp.Competitors {
+		team := Team{
+			ID:           competitor.Team.ID,
+			Name:         competitor.Team.DisplayName,
+			ShortName:    competitor.Team.ShortDisplayName,
+			Abbreviation: competitor.Team.Abbreviation,
+			Score:        string(competitor.Score),
+			Logo:         competitor.Team.Logo,
+			Winner:       competitor.Winner,
+		}
+		if team.ID == "" {
+			team.ID = competitor.ID
+		}
+		if team.Logo == "" && len(competitor.Team.Logos) > 0 {
+			team.Logo = competitor.Team.Logos[0].Href
+		}
+		for _, ls := range competitor.Linescores {
+			value := ls.DisplayValue
+			if value == "" {
+				value = strconv.FormatFloat(ls.Value, 'f', -1, 64)
+			}
+			team.LineScores = append(team.LineScores, value)
+		}
+		if competitor.Hits != nil {
+			team.Hits = strconv.FormatFloat(*competitor.Hits, 'f', -1, 64)
+		}
+		if competitor.Errors != nil {
+			team.Errors = strconv.FormatFloat(*competitor.Errors, 'f', -1, 64)
+		}
+
+		if competitor.HomeAway == "home" {
+			game.HomeTeam = team
+		} else {
+			game.AwayTeam = team
+		}
+	}
+
+	if len(comp.Odds) > 0 {
+		odds := comp.Odds[0]
+		game.Odds = &Odds{Details: odds.Details, Spread: odds.Spread, OverUnder: odds.OverUnder}
+		switch {
+		case odds.HomeTeamOdds.Favorite:
+			game.Odds.Favorite = "home"
+		case odds.AwayTeamOdds.Favorite:
+			game.Odds.Favorite = "away"
+		default:
+			game.Odds.Favorite = oddsFavorite(odds.Details, game)
+		}
+	}
+
+	return game, true
+}
+
+// oddsFavorite reads the favorite from an odds line such as "BOS -5.5",
+// which names the favorite's abbreviation. It returns "" for a pick'em or
+// an unrecognized line.
+func oddsFavorite(details string, game Game) string {
+	abbreviation, _, _ := strings.Cut(strings.TrimSpace(details), " ")
+	switch {
+	case abbreviation == "":
+		return ""
+	case strings.EqualFold(abbreviation, game.HomeTeam.Abbreviation):
+		return "home"
+	case strings.EqualFold(abbreviation, game.AwayTeam.Abbreviation):
+		return "away"
+	}
+	return ""
+}
+
+// Summary fetches the game summary (box score, plays, leaders, odds and
+// injuries) from ESPN.
+func (p *ESPNProvider) Summary(ctx context.Context, sport string, league string, eventID string) (*GameDetail, error) {
+	path := fmt.Sprintf("%s/%s/%s/summary?event=%s", sitePath, sport, league, eventID)
+
+	body, stale, err := p.client.get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch game details: %w", err)
+	}
+
+	detail, err := parseSummary(eventID, body)
+	if err != nil {
+		return nil, err
+	}
+	return detail, stale
+}
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// LeagueCatalog lists ESPN leagues beyond AvailableSports. DiscoverLeagues
+// probes it to find the ones currently serving scoreboards.
+var LeagueCatalog = []Sport{
+	{
+		Name: "Football",
+		ID:   "football",
+		Leagues: []League{
+			{Name: "CFL", ID: "cfl"},
+			{Name: "UFL", ID: "ufl"},
+		},
+	},
+	{
+		Name: "Basketball",
+		ID:   "basketball",
+		Leagues: []League{
+			{Name: "NBA G League", ID: "nba-development"},
+			{Name: "NBA Summer League", ID: "nba-summer-las-vegas"},
+		},
+	},
+	{
+		Name: "Hockey",
+		ID:   "hockey",
+		Leagues: []League{
+			{Name: "College Hockey (Men)", ID: "mens-college-hockey"},
+			{Name: "College Hockey (Women)", ID: "womens-college-hockey"},
+		},
+	},
+	{
+		Name: "Soccer",
+		ID:   "soccer",
+		Leagues: []League{
+			{Name: "Ligue 1", ID: "fra.1"},
+			{Name: "Eredivisie", ID: "ned.1"},
+			{Name: "Primeira Liga", ID: "por.1"},
+			{Name: "Scottish Premiership", ID: "sco.1"},
+			{Name: "EFL Championship", ID: "eng.2"},
+			{Name: "Liga MX", ID: "mex.1"},
+			{Name: "NWSL", ID: "usa.nwsl"},
+			{Name: "Europa League", ID: "uefa.europa"},
+			{Name: "Conference League", ID: "uefa.europa.conf"},
+		},
+	},
+}
+
+// MergeLeagues returns sports with the leagues of extra added. Leagues
+// already present are kept as they are, new leagues are appended to their
+// sport, and sports missing from sports are appended at the end. Neither
+// input is modified.
+func MergeLeagues(sports []Sport, extra []Sport) []Sport {
+	merged := make([]Sport, len(sports))
+	for i, sport := range sports {
+		merged[i] = sport
+		merged[i].Leagues = append([]League(nil), sport.Leagues...)
+	}
+
+	for _, sport := range extra {
+		i := sportIndex(merged, sport.ID)
+		if i < 0 {
+			merged = append(merged, Sport{Name: sport.Name, ID: sport.ID})
+			i = len(merged) - 1
+		}
+		for _, league := range sport.Leagues {
+			if !hasLeague(merged[i], league.ID) {
+				merged[i].Leagues = append(merged[i].Leagues, league)
+			}
+		}
+	}
+
+	return merged
+}
+
+// CustomSport builds a Sport holding one league, naming it from its IDs
+// when no name is given. It is the unit MergeLeagues takes for leagues
+// defined outside the package.
+func CustomSport(sportID, leagueID, leagueName string) Sport {
+	if leagueName == "" {
+		leagueName = strings.ToUpper(leagueID)
+	}
+	sportName := sportID
+	if i := sportIndex(AvailableSports, sportID); i >= 0 {
+		sportName = AvailableSports[i].Name
+	} else if sportName != "" {
+		sportName = strings.ToUpper(sportName[:1]) + sportName[1:]
+	}
+	return Sport{
+		Name:    sportName,
+		ID:      sportID,
+		Leagues: []League{{Name: leagueName, ID: leagueID}},
+	}
+}
+
+// ValidateLeague checks that provider serves a scoreboard for the league.
+func ValidateLeague(ctx context.Context, provider Provider, sport, league string) error {
+	return ValidateLeagues(ctx, provider, []LeagueRef{{Sport: sport, League: league}})[0]
+}
+
+// ValidateLeagues checks several leagues concurrently. The returned errors
+// are in the order of leagues, nil for each league that serves a
+// scoreboard or couldn't be checked for now, e.g. because ESPN is
+// unreachable.
+func ValidateLeagues(ctx context.Context, provider Provider, leagues []LeagueRef) []error {
+	errs := make([]error, len(leagues))
+	for i, result := range FetchScoreboards(ctx, provider, leagues, Day(time.Now())) {
+		if noScoreboard(result.Err) {
+			errs[i] = fmt.Errorf("%s/%s has no scoreboard: %w", result.Sport, result.League, result.Err)
+		}
+	}
+	return errs
+}
+
+// DiscoverLeagues probes every league in candidates concurrently and
+// returns the ones that serve a scoreboard, grouped by sport. Leagues that
+// couldn't be probed for now are kept.
+func DiscoverLeagues(ctx context.Context, provider Provider, candidates []Sport) []Sport {
+	var refs []LeagueRef
+	names := map[LeagueRef]string{}
+	for _, sport := range candidates {
+		for _, league := range sport.Leagues {
+			ref := LeagueRef{Sport: sport.ID, League: league.ID}
+			refs = append(refs, ref)
+			names[ref] = league.Name
+		}
+	}
+
+	var found []Sport
+	for _, result := range FetchScoreboards(ctx, provider, refs, Day(time.Now())) {
+		if noScoreboard(result.Err) {
+			continue
+		}
+		found = MergeLeagues(found, []Sport{CustomSport(result.Sport, result.League, names[result.LeagueRef])})
+	}
+	return found
+}
+
+// noScoreboard reports whether err shows that a league serves no
+// scoreboard: ESPN turned the request down or answered with something
+// else. Failures that may pass, such as timeouts, don't count.
+func noScoreboard(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && (apiErr.Kind == KindClient || apiErr.Kind == KindParse)
+}
+
+func sportIndex(sports []Sport, id string) int {
+	for i, sport := range sports {
+		if sport.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func hasLeague(sport Sport, id string) bool {
+	for _, league := range sport.Leagues {
+		if league.ID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+	"sync"
+)
+
+// LeagueRef identifies a league within a sport, e.g. {"basketball", "nba"}.
+type LeagueRef struct {
+	Sport  string
+	League string
+}
+
+// LeagueGames is the result of fetching one league's scoreboard.
+type LeagueGames struct {
+	LeagueRef
+	Games []Game
+	Err   error
+}
+
+// FetchScoreboards fetches the scoreboards of several leagues concurrently.
+// Results are returned in the order of leagues; a failing league reports
+// its error without affecting the others.
+func FetchScoreboards(ctx context.Context, provider Provider, leagues []LeagueRef, dates DateRange) []LeagueGames {
+	results := make([]LeagueGames, len(leagues))
+
+	var wg sync.WaitGroup
+	for i, league := range leagues {
+		wg.Add(1)
+		go func(i int, league LeagueRef) {
+			defer wg.Done()
+			games, err := provider.Scoreboard(ctx, league.Sport, league.League, dates)
+			results[i] = LeagueGames{LeagueRef: league, Games: games, Err: err}
+		}(i, league)
+	}
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrOffline is the reason given for cached data in offline mode, and for
+// failing requests the cache can't answer.
+var ErrOffline = errors.New("offline mode")
+
+// StaleError comes back alongside a result served from the cache because
+// fresh data couldn't be fetched: the API was unreachable or failing, or
+// the client is offline. The result is usable; the error says how old it
+// is and why.
+type StaleError struct {
+	// Since is when the data was fetched.
+	Since time.Time
+
+	// Err is why fresh data is missing.
+	Err error
+}
+
+func (e *StaleError) Error() string {
+	return fmt.Sprintf("showing data from %s: %v", e.Since.Format(time.RFC3339), e.Err)
+}
+
+func (e *StaleError) Unwrap() error {
+	return e.Err
+}
+
+// StaleSince reports whether err marks a result served from the cache, and
+// when that result was fetched.
+func StaleSince(err error) (time.Time, bool) {
+	var stale *StaleError
+	if errors.As(err, &stale) {
+		return stale.Since, true
+	}
+	return time.Time{}, false
+}
+
+// Failed reports whether fetching the league's scoreboard failed outright,
+// as opposed to succeeding or being served stale from the cache.
+func (r LeagueGames) Failed() bool {
+	_, stale := StaleSince(r.Err)
+	return r.Err != nil && !stale
+}
@@ -0,0 +1,40 @@
+package api
+
+import "context"
+
+// Provider is a source of scores and game data. The UI only talks to a
+// Provider, so ESPN can be swapped for another source, a fake or recorded
+// fixtures without touching the rendering code.
+//
+// A method may return its result together with a *StaleError when the
+// result is an old copy served because fresh data couldn't be fetched.
+// Check for it with StaleSince before treating an error as a failure.
+type Provider interface {
+	// Leagues returns the sports and leagues the provider can serve.
+	Leagues() []Sport
+
+	// Scoreboard returns the games for a league played on the given days,
+	// sorted by start time.
+	Scoreboard(ctx context.Context, sport string, league string, dates DateRange) ([]Game, error)
+
+	// Summary returns the detailed view of a single game.
+	Summary(ctx context.Context, sport string, league string, eventID string) (*GameDetail, error)
+
+	// Standings returns the league table grouped by conference/division.
+	Standings(ctx context.Context, sport string, league string) (*Standings, error)
+
+	// Team returns a team's profile and record.
+	Team(ctx context.Context, sport string, league string, teamID string) (*TeamInfo, error)
+
+	// TeamSchedule returns a team's season schedule, sorted by start time.
+	TeamSchedule(ctx context.Context, sport string, league string, teamID string) ([]Game, error)
+
+	// Roster returns the players on a team.
+	Roster(ctx context.Context, sport string, league string, teamID string) ([]Athlete, error)
+
+	// Athlete returns a player's profile and season statistics.
+	Athlete(ctx context.Context, sport string, league string, athleteID string) (*AthleteProfile, error)
+}
+
+// DefaultProvider backs the package-level helpers such as GetGames.
+var DefaultProvider Provider = NewESPNProvider(DefaultClient)
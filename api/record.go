@@ -0,0 +1,171 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Recordings hold one directory per endpoint, each with a snapshot per
+// distinct response named by when it was captured:
+//
+//	<dir>/basketball/nba/scoreboard/20240301-20240303/20240302T013005.120Z.json
+//	<dir>/basketball/nba/summary/401585601/20240302T013005.120Z.json
+const (
+	recordScoreboard = "scoreboard"
+	recordSummary    = "summary"
+
+	snapshotLayout = "20060102T150405.000Z"
+	snapshotExt    = ".json"
+)
+
+// recordSegment matches path segments and query values safe to use as
+// directory names.
+var recordSegment = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// Recorder is an http.RoundTripper that saves every successful scoreboard
+// and summary response under Dir for ReplayProvider to serve back. A
+// response identical to the previous one for the same endpoint isn't saved
+// again, so polling a finished game doesn't grow the recording.
+type Recorder struct {
+	// Dir is the recording directory, created as needed.
+	Dir string
+
+	// Next sends the requests. http.DefaultTransport is used when nil.
+	Next http.RoundTripper
+
+	mu   sync.Mutex
+	last map[string][sha256.Size]byte
+}
+
+// NewRecorder returns a Recorder saving to dir the responses to requests
+// sent through next.
+func NewRecorder(dir string, next http.RoundTripper) *Recorder {
+	return &Recorder{Dir: dir, Next: next}
+}
+
+// RoundTrip sends req and records the response when it is a scoreboard or
+// summary. Failing to save it fails the request, so a recording is never
+// silently incomplete.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := r.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	resp, err := next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+	endpoint, ok := recordEndpoint(req.URL)
+	if !ok {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err := r.save(endpoint, body, time.Now()); err != nil {
+		return nil, fmt.Errorf("failed to record response: %w", err)
+	}
+	return resp, nil
+}
+
+// save writes body as the endpoint's snapshot captured at t, unless it
+// matches the last one saved, in this run or an earlier one.
+func (r *Recorder) save(endpoint string, body []byte, t time.Time) error {
+	sum := sha256.Sum256(body)
+	dir := filepath.Join(r.Dir, endpoint)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	last, ok := r.last[endpoint]
+	if !ok {
+		last, ok = latestSnapshotSum(dir)
+	}
+	if ok && last == sum {
+		return nil
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	name := filepath.Join(dir, t.UTC().Format(snapshotLayout)+snapshotExt)
+	if err := os.WriteFile(name, body, 0o644); err != nil {
+		return err
+	}
+
+	if r.last == nil {
+		r.last = map[string][sha256.Size]byte{}
+	}
+	r.last[endpoint] = sum
+	return nil
+}
+
+// latestSnapshotSum hashes the newest snapshot in dir, reporting false
+// when there is none.
+func latestSnapshotSum(dir string) ([sha256.Size]byte, bool) {
+	names, _ := filepath.Glob(filepath.Join(dir, "*"+snapshotExt))
+	if len(names) == 0 {
+		return [sha256.Size]byte{}, false
+	}
+	// Snapshot names sort by capture time
+	sort.Strings(names)
+	body, err := os.ReadFile(names[len(names)-1])
+	if err != nil {
+		return [sha256.Size]byte{}, false
+	}
+	return sha256.Sum256(body), true
+}
+
+// recordEndpoint returns the directory, relative to a recording, holding
+// snapshots of the scoreboard or summary at u. It reports false for other
+// requests.
+func recordEndpoint(u *url.URL) (string, bool) {
+	_, rest, ok := strings.Cut(u.Path, sitePath+"/")
+	if !ok {
+		return "", false
+	}
+	parts := strings.Split(rest, "/")
+	if len(parts) != 3 {
+		return "", false
+	}
+	sport, league, kind := parts[0], parts[1], parts[2]
+
+	var id string
+	switch kind {
+	case recordScoreboard:
+		id = u.Query().Get("dates")
+	case recordSummary:
+		id = u.Query().Get("event")
+	default:
+		return "", false
+	}
+	return snapshotDir(sport, league, kind, id)
+}
+
+// snapshotDir joins the parts of an endpoint's directory, reporting false
+// when one of them isn't safe as a directory name.
+func snapshotDir(sport, league, kind, id string) (string, bool) {
+	parts := []string{sport, league, kind, id}
+	for _, part := range parts {
+		if !recordSegment.MatchString(part) || part == "." || part == ".." {
+			return "", false
+		}
+	}
+	return filepath.Join(parts...), true
+}
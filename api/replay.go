@@ -0,0 +1,296 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrNotRecorded is the reason ReplayProvider gives for data missing from
+// its recording.
+var ErrNotRecorded = errors.New("not in the recording")
+
+// ReplayProvider serves a recording made with Recorder, for demos and
+// development without network access. Only scoreboards and summaries are
+// recorded; the other Provider methods fail with ErrNotRecorded.
+//
+// The recording is moved to the present: the day it began is replayed as
+// today, and its snapshots are served on a timeline starting when the
+// provider is created, so a game recorded live plays out again.
+type ReplayProvider struct {
+	// Speed scales the timeline: 2 plays a recording back in half the
+	// time it took. Zero serves the last snapshot of everything.
+	Speed float64
+
+	dir       string
+	snapshots map[string][]snapshot // by endpoint, oldest first
+	leagues   []Sport
+	first     time.Time // when the recording began
+	start     time.Time // when the replay began
+	shift     int       // days from the recording to the replay
+}
+
+// snapshot is one recorded response.
+type snapshot struct {
+	at   time.Time
+	file string
+}
+
+// NewReplayProvider loads the recording in dir, to be played back at
+// speed, with days counted in loc. It fails when dir holds no snapshots.
+func NewReplayProvider(dir string, speed float64, loc *time.Location) (*ReplayProvider, error) {
+	p := &ReplayProvider{
+		Speed:     speed,
+		dir:       dir,
+		snapshots: map[string][]snapshot{},
+		start:     time.Now().In(loc),
+	}
+
+	recorded := map[LeagueRef]bool{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, snapshotExt) {
+			return err
+		}
+		at, err := time.Parse(snapshotLayout, strings.TrimSuffix(d.Name(), snapshotExt))
+		if err != nil {
+			// Not a snapshot
+			return nil
+		}
+		endpoint, err := filepath.Rel(dir, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		parts := strings.Split(filepath.ToSlash(endpoint), "/")
+		if len(parts) != 4 {
+			return nil
+		}
+
+		p.snapshots[endpoint] = append(p.snapshots[endpoint], snapshot{at: at, file: path})
+		recorded[LeagueRef{Sport: parts[0], League: parts[1]}] = true
+		if p.first.IsZero() || at.Before(p.first) {
+			p.first = at
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recording: %w", err)
+	}
+	if len(p.snapshots) == 0 {
+		return nil, fmt.Errorf("no recorded responses in %s", dir)
+	}
+
+	for _, snapshots := range p.snapshots {
+		sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].at.Before(snapshots[j].at) })
+	}
+	p.leagues = recordedLeagues(recorded)
+
+	first := startOfDay(p.first.In(loc))
+	today := startOfDay(p.start)
+	p.shift = int(math.Round(today.Sub(first).Hours() / 24))
+
+	return p, nil
+}
+
+// recordedLeagues lists the leagues in a recording in the usual order,
+// named as in AvailableSports and LeagueCatalog where they appear.
+func recordedLeagues(recorded map[LeagueRef]bool) []Sport {
+	var sports []Sport
+	named := map[LeagueRef]bool{}
+	for _, known := range append(append([]Sport{}, AvailableSports...), LeagueCatalog...) {
+		for _, league := range known.Leagues {
+			ref := LeagueRef{Sport: known.ID, League: league.ID}
+			if recorded[ref] && !named[ref] {
+				named[ref] = true
+				sports = MergeLeagues(sports, []Sport{CustomSport(ref.Sport, ref.League, league.Name)})
+			}
+		}
+	}
+
+	var others []LeagueRef
+	for ref := range recorded {
+		if !named[ref] {
+			others = append(others, ref)
+		}
+	}
+	sort.Slice(others, func(i, j int) bool {
+		if others[i].Sport != others[j].Sport {
+			return others[i].Sport < others[j].Sport
+		}
+		return others[i].League < others[j].League
+	})
+	for _, ref := range others {
+		sports = MergeLeagues(sports, []Sport{CustomSport(ref.Sport, ref.League, "")})
+	}
+	return sports
+}
+
+// Leagues returns the leagues in the recording.
+func (p *ReplayProvider) Leagues() []Sport {
+	return p.leagues
+}
+
+// position returns the point in the recording being replayed now.
+func (p *ReplayProvider) position() time.Time {
+	elapsed := time.Duration(float64(time.Since(p.start)) * p.Speed)
+	return p.first.Add(elapsed)
+}
+
+// read returns the endpoint's snapshot at the current position: the last
+// one captured by then, or its first when it was captured later.
+func (p *ReplayProvider) read(sport, league, kind, id string) ([]byte, error) {
+	endpoint, ok := snapshotDir(sport, league, kind, id)
+	if !ok {
+		return nil, ErrNotRecorded
+	}
+	snapshots := p.snapshots[endpoint]
+	if len(snapshots) == 0 {
+		return nil, ErrNotRecorded
+	}
+
+	i := len(snapshots) - 1
+	if p.Speed > 0 {
+		at := p.position()
+		i = sort.Search(len(snapshots), func(i int) bool { return snapshots[i].at.After(at) }) - 1
+		if i < 0 {
+			i = 0
+		}
+	}
+
+	body, err := os.ReadFile(snapshots[i].file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recording: %w", err)
+	}
+	return body, nil
+}
+
+// Scoreboard replays the league scoreboard for the given days, with the
+// games moved from the recorded days to them. Any recorded scoreboard
+// spanning the days serves them, so stepping a day from the one recorded
+// replays it too.
+func (p *ReplayProvider) Scoreboard(ctx context.Context, sport string, league string, dates DateRange) ([]Game, error) {
+	recorded := DateRange{Start: dates.Start.AddDate(0, 0, -p.shift), End: dates.End.AddDate(0, 0, -p.shift)}
+	param, ok := p.recordedDates(sport, league, recorded)
+	if !ok {
+		return nil, fmt.Errorf("failed to fetch games: %w", ErrNotRecorded)
+	}
+	body, err := p.read(sport, league, recordScoreboard, param)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch games: %w", err)
+	}
+
+	games, err := parseScoreboard(body, recorded)
+	if err != nil {
+		return nil, err
+	}
+	for i := range games {
+		games[i].Date = games[i].Date.AddDate(0, 0, p.shift)
+	}
+	return games, nil
+}
+
+// recordedDates returns the dates parameter of the recorded scoreboard to
+// serve the days in r from. Of the recordings spanning those days, it
+// picks the one covering most of the widened query ESPN would be sent,
+// then the narrowest, so the exact query is used when it was recorded.
+func (p *ReplayProvider) recordedDates(sport, league string, r DateRange) (string, bool) {
+	dir := filepath.Join(sport, league, recordScoreboard)
+	start, _ := time.Parse(dayParam, r.Start.Format(dayParam))
+	end, _ := time.Parse(dayParam, r.End.Format(dayParam))
+	wantStart, wantEnd := start.AddDate(0, 0, -1), end.AddDate(0, 0, 1)
+
+	var best string
+	var bestOverlap, bestWidth time.Duration
+	for endpoint := range p.snapshots {
+		if filepath.Dir(endpoint) != dir {
+			continue
+		}
+		param := filepath.Base(endpoint)
+		from, to, ok := parseDatesParam(param)
+		if !ok || from.After(start) || to.Before(end) {
+			continue
+		}
+
+		overlap := minTime(to, wantEnd).Sub(maxTime(from, wantStart))
+		width := to.Sub(from)
+		better := overlap > bestOverlap ||
+			overlap == bestOverlap && width < bestWidth ||
+			overlap == bestOverlap && width == bestWidth && param < best
+		if best == "" || better {
+			best, bestOverlap, bestWidth = param, overlap, width
+		}
+	}
+	return best, best != ""
+}
+
+// parseDatesParam parses a scoreboard dates parameter, a day or a range
+// of days such as 20240301-20240303, into its first and last day.
+func parseDatesParam(param string) (start, end time.Time, ok bool) {
+	first, last, found := strings.Cut(param, "-")
+	if !found {
+		last = first
+	}
+	start, err := time.Parse(dayParam, first)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	end, err = time.Parse(dayParam, last)
+	if err != nil || end.Before(start) {
+		return time.Time{}, time.Time{}, false
+	}
+	return start, end, true
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// Summary replays the game summary.
+func (p *ReplayProvider) Summary(ctx context.Context, sport string, league string, eventID string) (*GameDetail, error) {
+	body, err := p.read(sport, league, recordSummary, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch game details: %w", err)
+	}
+	return parseSummary(eventID, body)
+}
+
+// Standings are not recorded.
+func (p *ReplayProvider) Standings(ctx context.Context, sport string, league string) (*Standings, error) {
+	return nil, fmt.Errorf("failed to fetch standings: %w", ErrNotRecorded)
+}
+
+// Team is not recorded.
+func (p *ReplayProvider) Team(ctx context.Context, sport string, league string, teamID string) (*TeamInfo, error) {
+	return nil, fmt.Errorf("failed to fetch team: %w", ErrNotRecorded)
+}
+
+// TeamSchedule is not recorded.
+func (p *ReplayProvider) TeamSchedule(ctx context.Context, sport string, league string, teamID string) ([]Game, error) {
+	return nil, fmt.Errorf("failed to fetch schedule: %w", ErrNotRecorded)
+}
+
+// Roster is not recorded.
+func (p *ReplayProvider) Roster(ctx context.Context, sport string, league string, teamID string) ([]Athlete, error) {
+	return nil, fmt.Errorf("failed to fetch roster: %w", ErrNotRecorded)
+}
+
+// Athlete is not recorded.
+func (p *ReplayProvider) Athlete(ctx context.Context, sport string, league string, athleteID string) (*AthleteProfile, error) {
+	return nil, fmt.Errorf("failed to fetch athlete: %w", ErrNotRecorded)
+}
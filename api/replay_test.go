@@ -0,0 +1,173 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// scoreboardServer serves an NBA scoreboard with a game today and one
+// tomorrow, the first scored as set.
+type scoreboardServer struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	today time.Time
+	score int
+}
+
+func newScoreboardServer(t *testing.T, today time.Time) *scoreboardServer {
+	s := &scoreboardServer{today: today}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		fmt.Fprintf(w, `{"events":[%s,%s]}`,
+			scoreboardEvent("1", s.today.Add(20*time.Hour), s.score),
+			scoreboardEvent("2", s.today.AddDate(0, 0, 1).Add(20*time.Hour), 0))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *scoreboardServer) setScore(score int) {
+	s.mu.Lock()
+	s.score = score
+	s.mu.Unlock()
+}
+
+func scoreboardEvent(id string, date time.Time, score int) string {
+	return fmt.Sprintf(`{"id":%q,"date":%q,"competitions":[{"status":{"type":{"state":"in"}},"competitors":[`+
+		`{"homeAway":"home","team":{"id":"2"},"score":"%d"},{"homeAway":"away","team":{"id":"18"},"score":"0"}]}]}`,
+		id, date.Format(time.RFC3339), score)
+}
+
+// record fetches today's scoreboard once per score through a Recorder,
+// returning the recording directory.
+func record(t *testing.T, s *scoreboardServer, today time.Time, scores ...int) string {
+	t.Helper()
+	dir := t.TempDir()
+	client := &Client{BaseURL: s.URL, HTTPClient: &http.Client{Transport: NewRecorder(dir, s.Client().Transport)}}
+	provider := NewESPNProvider(client)
+	for _, score := range scores {
+		s.setScore(score)
+		if _, err := provider.Scoreboard(context.Background(), "basketball", "nba", Day(today)); err != nil {
+			t.Fatal(err)
+		}
+		// Snapshots are named to the millisecond
+		time.Sleep(10 * time.Millisecond)
+	}
+	return dir
+}
+
+func gameIDs(games []Game) []string {
+	var ids []string
+	for _, game := range games {
+		ids = append(ids, game.ID)
+	}
+	return ids
+}
+
+func TestReplayDayStep(t *testing.T) {
+	today := startOfDay(time.Now().UTC())
+	dir := record(t, newScoreboardServer(t, today), today, 0)
+	p, err := NewReplayProvider(dir, 0, time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		day  time.Time
+		want []string
+	}{
+		{"today", today, []string{"1"}},
+		{"tomorrow", today.AddDate(0, 0, 1), []string{"2"}},
+		{"yesterday", today.AddDate(0, 0, -1), nil},
+	}
+	for _, tt := range tests {
+		games, err := p.Scoreboard(context.Background(), "basketball", "nba", Day(tt.day))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got := gameIDs(games); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("%s: games = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	for _, day := range []time.Time{today.AddDate(0, 0, 2), today.AddDate(0, 0, -2)} {
+		if _, err := p.Scoreboard(context.Background(), "basketball", "nba", Day(day)); !errors.Is(err, ErrNotRecorded) {
+			t.Errorf("%s: err = %v, want ErrNotRecorded", day.Format("Jan 2"), err)
+		}
+	}
+}
+
+func TestReplayTimeline(t *testing.T) {
+	today := startOfDay(time.Now().UTC())
+	scores := []int{3, 5, 8}
+	dir := record(t, newScoreboardServer(t, today), today, scores...)
+	p, err := NewReplayProvider(dir, 1, time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshots := p.snapshots[filepath.Join("basketball", "nba", recordScoreboard, Day(today).queryParam())]
+	if len(snapshots) != len(scores) {
+		t.Fatalf("recorded %d snapshots, want %d", len(snapshots), len(scores))
+	}
+
+	// A slow replay, started so that it is just past each snapshot,
+	// stays there for the rest of the test
+	const speed = 1e-6
+	p.Speed = speed
+	for i, want := range scores {
+		offset := snapshots[i].at.Sub(p.first) + time.Millisecond
+		p.start = time.Now().Add(-time.Duration(float64(offset) / speed))
+		for _, day := range []time.Time{today, today.AddDate(0, 0, 1)} {
+			games, err := p.Scoreboard(context.Background(), "basketball", "nba", Day(day))
+			if err != nil {
+				t.Fatalf("snapshot %d: %v", i, err)
+			}
+			if len(games) != 1 {
+				t.Fatalf("snapshot %d, %s: %d games, want 1", i, day.Format("Jan 2"), len(games))
+			}
+			if day.Equal(today) && games[0].HomeTeam.Score != fmt.Sprint(want) {
+				t.Errorf("snapshot %d: score = %s, want %d", i, games[0].HomeTeam.Score, want)
+			}
+		}
+	}
+}
+
+func TestRecordedDates(t *testing.T) {
+	p := &ReplayProvider{snapshots: map[string][]snapshot{}}
+	for _, param := range []string{"20240229-20240302", "20240301-20240303", "20240305"} {
+		p.snapshots[filepath.Join("basketball", "nba", recordScoreboard, param)] = nil
+	}
+	p.snapshots[filepath.Join("hockey", "nhl", recordScoreboard, "20240301-20240320")] = nil
+
+	day := func(d int) time.Time { return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC) }
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       string
+	}{
+		{"exact query", day(2), day(2), "20240301-20240303"},
+		{"another exact query", day(1), day(1), "20240229-20240302"},
+		{"day step", day(3), day(3), "20240301-20240303"},
+		{"several days", day(1), day(2), "20240229-20240302"},
+		{"single day", day(5), day(5), "20240305"},
+		{"not recorded", day(4), day(4), ""},
+		{"recorded for another league", day(10), day(10), ""},
+	}
+	for _, tt := range tests {
+		got, _ := p.recordedDates("basketball", "nba", DateRange{Start: tt.start, End: tt.end})
+		if got != tt.want {
+			t.Errorf("%s: recordedDates = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
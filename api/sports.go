@@ -1,18 +1,11 @@
 package api
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
-	"net/http"
-	"sort"
 	"time"
 )
 
-const (
-	espnAPIBase = "https://site.api.espn.com/apis/site/v2/sports"
-)
-
 type Sport struct {
 	Name    string
 	ID      string
@@ -25,22 +18,42 @@ type League struct {
 }
 
 type Game struct {
-	ID        string
-	Name      string
-	ShortName string
-	Date      time.Time
-	Status    string
-	HomeTeam  Team
-	AwayTeam  Team
-	IsLive    bool
-	Venue     string
+	ID           string
+	Name         string
+	ShortName    string
+	Date         time.Time
+	Status       string
+	StatusDetail string
+	State        string // "pre", "in" or "post"
+	Period       int
+	Clock        string
+	HomeTeam     Team
+	AwayTeam     Team
+	IsLive       bool
+	Completed    bool
+	Venue        string
+	Odds         *Odds // nil when the scoreboard carries no line
 }
 
 type Team struct {
-	Name      string
-	ShortName string
-	Score     string
-	Logo      string
+	ID           string
+	Name         string
+	ShortName    string
+	Abbreviation string
+	Score        string
+	Logo         string
+	Winner       bool
+	LineScores   []string
+	Hits         string
+	Errors       string
+}
+
+// Odds is a game's betting line as reported on the scoreboard.
+type Odds struct {
+	Details   string // e.g. "BOS -5.5"
+	Spread    float64
+	OverUnder float64
+	Favorite  string // "home", "away", or "" when unknown or even
 }
 
 type GameDetail struct {
@@ -48,6 +61,7 @@ type GameDetail struct {
 	Name         string
 	Status       string
 	StatusDetail string
+	State        string // "pre", "in" or "post"
 	IsLive       bool
 	HomeTeam     TeamDetail
 	AwayTeam     TeamDetail
@@ -57,15 +71,47 @@ type GameDetail struct {
 	Leaders      []Leader
 	Period       string
 	Clock        string
+	Odds         *Odds // nil when the summary carries no line
+	Injuries     []Injury
 }
 
 type TeamDetail struct {
+	ID           string
+	Name         string
+	ShortName    string
+	Abbreviation string
+	Score        string
+	Record       string
+	Logo         string
+	LineScores   []string
+	Hits         string
+	Errors       string
+	Statistics   []Statistic
+	BoxScore     []BoxScoreGroup
+}
+
+// BoxScoreGroup is one table of a team's player box score, such as
+// "Passing" in football or "Batting" in baseball. Basketball box scores
+// have a single unnamed group.
+type BoxScoreGroup struct {
+	Name    string
+	Labels  []string
+	Players []BoxScorePlayer
+	Totals  []string
+}
+
+// BoxScorePlayer is one athlete's row in a BoxScoreGroup. Stats line up
+// with the group's Labels.
+type BoxScorePlayer struct {
+	AthleteID  string
 	Name       string
 	ShortName  string
-	Score      string
-	Record     string
-	Logo       string
-	Statistics []Statistic
+	Jersey     string
+	Position   string
+	Starter    bool
+	DidNotPlay bool
+	Reason     string
+	Stats      []string
 }
 
 type Statistic struct {
@@ -82,43 +128,77 @@ type Play struct {
 }
 
 type Leader struct {
-	Category string
-	Team     string
-	Athlete  string
-	Value    string
+	Category  string
+	Team      string
+	TeamID    string
+	Athlete   string
+	AthleteID string
+	Value     string
+}
+
+// Injury is a player on a team's injury report for a game.
+type Injury struct {
+	TeamID    string
+	Team      string
+	AthleteID string
+	Athlete   string
+	Position  string
+	Status    string // e.g. "Out" or "Questionable"
+	Detail    string // e.g. "Knee", when reported
 }
 
-type ESPNResponse struct {
-	Events []struct {
-		ID           string `json:"id"`
-		Name         string `json:"name"`
-		ShortName    string `json:"shortName"`
-		Date         string `json:"date"`
-		Competitions []struct {
-			ID    string `json:"id"`
-			Venue struct {
-				FullName string `json:"fullName"`
-			} `json:"venue"`
-			Status struct {
-				Type struct {
-					State       string `json:"state"`
-					Completed   bool   `json:"completed"`
-					Description string `json:"description"`
-				} `json:"type"`
-			} `json:"status"`
-			Competitors []struct {
-				ID       string `json:"id"`
-				HomeAway string `json:"homeAway"`
-				Winner   bool   `json:"winner"`
-				Team     struct {
-					DisplayName      string `json:"displayName"`
-					ShortDisplayName string `json:"shortDisplayName"`
-					Logo             string `json:"logo"`
-				} `json:"team"`
-				Score string `json:"score"`
-			} `json:"competitors"`
-		} `json:"competitions"`
-	} `json:"events"`
+// RegulationPeriods returns how many periods (quarters, halves, periods or
+// innings) a game in the league lasts before overtime or extra innings.
+func RegulationPeriods(sport string, league string) int {
+	switch sport {
+	case "football":
+		return 4
+	case "basketball":
+		if league == "mens-college-basketball" {
+			return 2
+		}
+		return 4
+	case "hockey":
+		return 3
+	case "soccer":
+		return 2
+	case "baseball":
+		return 9
+	}
+	return 4
+}
+
+// PeriodLabel names the i-th (zero-based) period column. Periods beyond
+// regulation are overtimes, extra time or extra innings.
+func PeriodLabel(sport string, regulation, i int) string {
+	switch sport {
+	case "baseball":
+		return fmt.Sprintf("%d", i+1)
+	case "soccer":
+		switch {
+		case i < regulation:
+			return fmt.Sprintf("%dH", i+1)
+		case i < regulation+2:
+			return "ET"
+		}
+		return "PK"
+	case "hockey":
+		switch {
+		case i < regulation:
+			return fmt.Sprintf("%d", i+1)
+		case i == regulation:
+			return "OT"
+		}
+		return "SO"
+	}
+
+	if i < regulation {
+		return fmt.Sprintf("%d", i+1)
+	}
+	if i == regulation {
+		return "OT"
+	}
+	return fmt.Sprintf("%dOT", i-regulation+1)
 }
 
 var AvailableSports = []Sport{
@@ -173,312 +253,46 @@ func GetGames(sport string, league string) ([]Game, error) {
 	return GetGamesWithOptions(sport, league, false)
 }
 
+// GetGamesWithOptions returns today's games, or the games in DefaultWindow
+// when includeUpcoming is set.
 func GetGamesWithOptions(sport string, league string, includeUpcoming bool) ([]Game, error) {
-	url := fmt.Sprintf("%s/%s/%s/scoreboard", espnAPIBase, sport, league)
-
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-	}
-
-	resp, err := client.Get(url)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch games: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API returned status code: %d", resp.StatusCode)
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	var espnResp ESPNResponse
-	if err := json.Unmarshal(body, &espnResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	games := make([]Game, 0, len(espnResp.Events))
-	now := time.Now()
-
-	// Set date range based on whether to include upcoming games
-	var cutoffDate, futureDate time.Time
+	dates := Day(time.Now())
 	if includeUpcoming {
-		// Show today through October 19, 2025 (upcoming games)
-		cutoffDate = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
-		futureDate = time.Date(2025, 10, 19, 23, 59, 59, 0, now.Location())
-	} else {
-		// Show today's games only (current games)
-		cutoffDate = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
-		futureDate = time.Date(now.Year(), now.Month(), now.Day(), 23, 59, 59, 0, now.Location())
-	}
-
-	for _, event := range espnResp.Events {
-		if len(event.Competitions) == 0 {
-			continue
-		}
-
-		comp := event.Competitions[0]
-
-		// Parse date first to filter
-		var gameDate time.Time
-		if t, err := time.Parse(time.RFC3339, event.Date); err == nil {
-			gameDate = t
-		} else if t, err := time.Parse("2006-01-02T15:04Z", event.Date); err == nil {
-			// ESPN sometimes returns dates without seconds
-			gameDate = t
-		} else {
-			continue // Skip games with invalid dates
-		}
-
-		// Debug: Print date comparison (remove this later)
-		// fmt.Printf("Game: %s, Date: %s, Cutoff: %s, Future: %s\n",
-		//     event.Name, gameDate.Format("2006-01-02"),
-		//     cutoffDate.Format("2006-01-02"), futureDate.Format("2006-01-02"))
-
-		// Only include games within our date range
-		if gameDate.Before(cutoffDate) || gameDate.After(futureDate) {
-			continue
-		}
-
-		game := Game{
-			ID:        event.ID,
-			Name:      event.Name,
-			ShortName: event.ShortName,
-			Status:    comp.Status.Type.Description,
-			IsLive:    comp.Status.Type.State == "in",
-			Venue:     comp.Venue.FullName,
-			Date:      gameDate,
-		}
-
-		// Extract team information
-		for _, competitor := range comp.Competitors {
-			team := Team{
-				Name:      competitor.Team.DisplayName,
-				ShortName: competitor.Team.ShortDisplayName,
-				Score:     competitor.Score,
-				Logo:      competitor.Team.Logo,
-			}
-
-			if competitor.HomeAway == "home" {
-				game.HomeTeam = team
-			} else {
-				game.AwayTeam = team
-			}
-		}
-
-		games = append(games, game)
+		dates = DefaultWindow.Range(time.Now())
 	}
+	return GetGamesForDates(sport, league, dates)
+}
 
-	// Sort games by date (chronologically)
-	sort.Slice(games, func(i, j int) bool {
-		return games[i].Date.Before(games[j].Date)
-	})
+// GetGamesOnDate returns the games played on the day containing date.
+func GetGamesOnDate(sport string, league string, date time.Time) ([]Game, error) {
+	return GetGamesForDates(sport, league, Day(date))
+}
 
-	return games, nil
+// GetGamesForDates returns the games played on the given days.
+func GetGamesForDates(sport string, league string, dates DateRange) ([]Game, error) {
+	return DefaultProvider.Scoreboard(context.Background(), sport, league, dates)
 }
 
 func GetGameDetail(sport string, league string, eventID string) (*GameDetail, error) {
-	url := fmt.Sprintf("%s/%s/%s/summary?event=%s", espnAPIBase, sport, league, eventID)
-
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-	}
-
-	resp, err := client.Get(url)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch game details: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API returned status code: %d", resp.StatusCode)
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	var result map[string]interface{}
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	detail := &GameDetail{
-		ID: eventID,
-	}
-
-	// Extract header info
-	if header, ok := result["header"].(map[string]interface{}); ok {
-		if competitions, ok := header["competitions"].([]interface{}); ok && len(competitions) > 0 {
-			comp := competitions[0].(map[string]interface{})
-
-			// Status
-			if status, ok := comp["status"].(map[string]interface{}); ok {
-				if statusType, ok := status["type"].(map[string]interface{}); ok {
-					detail.Status = getString(statusType, "description")
-					detail.StatusDetail = getString(statusType, "detail")
-					detail.IsLive = getString(statusType, "state") == "in"
-				}
-				detail.Period = getString(status, "period")
-				detail.Clock = getString(status, "displayClock")
-			}
-
-			// Venue
-			if venue, ok := comp["venue"].(map[string]interface{}); ok {
-				detail.Venue = getString(venue, "fullName")
-			}
-
-			// Attendance
-			if attendance, ok := comp["attendance"].(float64); ok {
-				detail.Attendance = fmt.Sprintf("%.0f", attendance)
-			}
-
-			// Teams
-			if competitors, ok := comp["competitors"].([]interface{}); ok {
-				for _, c := range competitors {
-					competitor := c.(map[string]interface{})
-					teamDetail := parseTeamDetail(competitor)
-
-					if getString(competitor, "homeAway") == "home" {
-						detail.HomeTeam = teamDetail
-					} else {
-						detail.AwayTeam = teamDetail
-					}
-				}
-			}
-		}
-	}
-
-	// Extract box score
-	if boxscore, ok := result["boxscore"].(map[string]interface{}); ok {
-		if teams, ok := boxscore["teams"].([]interface{}); ok {
-			for _, t := range teams {
-				team := t.(map[string]interface{})
-				teamName := getString(team, "team", "displayName")
-
-				stats := []Statistic{}
-				if statistics, ok := team["statistics"].([]interface{}); ok {
-					for _, s := range statistics {
-						stat := s.(map[string]interface{})
-						stats = append(stats, Statistic{
-							Label: getString(stat, "label"),
-							Value: getString(stat, "displayValue"),
-						})
-					}
-				}
-
-				// Match to home/away team by comparing team names
-				if teamName == detail.HomeTeam.Name {
-					detail.HomeTeam.Statistics = stats
-				} else if teamName == detail.AwayTeam.Name {
-					detail.AwayTeam.Statistics = stats
-				}
-			}
-		}
-	}
-
-	// Extract plays (last 20 significant plays)
-	if plays, ok := result["plays"].([]interface{}); ok {
-		playCount := 0
-		for i := len(plays) - 1; i >= 0 && playCount < 20; i-- {
-			play := plays[i].(map[string]interface{})
-
-			// Only include significant plays
-			if scoringPlay, _ := play["scoringPlay"].(bool); scoringPlay ||
-				getString(play, "type", "text") != "" {
-
-				detail.Plays = append([]Play{{
-					Period:      getString(play, "period", "displayValue"),
-					Clock:       getString(play, "clock", "displayValue"),
-					Text:        getString(play, "text"),
-					ScoringPlay: scoringPlay,
-					Team:        getString(play, "team", "shortDisplayName"),
-				}}, detail.Plays...)
-				playCount++
-			}
-		}
-	}
-
-	// Extract leaders
-	if leaders, ok := result["leaders"].([]interface{}); ok {
-		for _, teamLeader := range leaders {
-			teamLeaderMap := teamLeader.(map[string]interface{})
-			teamName := getString(teamLeaderMap, "team", "displayName")
-
-			// Get the leaders array for this team
-			if teamLeaders, ok := teamLeaderMap["leaders"].([]interface{}); ok {
-				for _, categoryLeader := range teamLeaders {
-					categoryLeaderMap := categoryLeader.(map[string]interface{})
-					categoryName := getString(categoryLeaderMap, "displayName")
-
-					// Get the actual leaders for this category
-					if categoryLeaders, ok := categoryLeaderMap["leaders"].([]interface{}); ok && len(categoryLeaders) > 0 {
-						// Take the first (top) leader for this category
-						topLeader := categoryLeaders[0].(map[string]interface{})
-						athleteName := getString(topLeader, "athlete", "displayName")
-						if athleteName == "" {
-							athleteName = getString(topLeader, "athlete", "fullName")
-						}
-						if athleteName == "" {
-							athleteName = getString(topLeader, "athlete", "shortName")
-						}
-
-						detail.Leaders = append(detail.Leaders, Leader{
-							Category: categoryName,
-							Athlete:  athleteName,
-							Team:     teamName,
-							Value:    getString(topLeader, "displayValue"),
-						})
-					}
-				}
-			}
-		}
-	}
-
-	if result["gameInfo"] != nil {
-		detail.Name = getString(result, "gameInfo", "venue", "fullName")
-	}
-
-	return detail, nil
+	return DefaultProvider.Summary(context.Background(), sport, league, eventID)
 }
 
-func parseTeamDetail(competitor map[string]interface{}) TeamDetail {
-	td := TeamDetail{}
-
-	if team, ok := competitor["team"].(map[string]interface{}); ok {
-		td.Name = getString(team, "displayName")
-		td.ShortName = getString(team, "shortDisplayName")
-		td.Logo = getString(team, "logo")
-	}
+func GetStandings(sport string, league string) (*Standings, error) {
+	return DefaultProvider.Standings(context.Background(), sport, league)
+}
 
-	td.Score = getString(competitor, "score")
+func GetTeam(sport string, league string, teamID string) (*TeamInfo, error) {
+	return DefaultProvider.Team(context.Background(), sport, league, teamID)
+}
 
-	if records, ok := competitor["records"].([]interface{}); ok && len(records) > 0 {
-		record := records[0].(map[string]interface{})
-		td.Record = getString(record, "summary")
-	}
+func GetTeamSchedule(sport string, league string, teamID string) ([]Game, error) {
+	return DefaultProvider.TeamSchedule(context.Background(), sport, league, teamID)
+}
 
-	return td
+func GetRoster(sport string, league string, teamID string) ([]Athlete, error) {
+	return DefaultProvider.Roster(context.Background(), sport, league, teamID)
 }
 
-func getString(m map[string]interface{}, keys ...string) string {
-	current := m
-	for i, key := range keys {
-		if i == len(keys)-1 {
-			if val, ok := current[key].(string); ok {
-				return val
-			}
-			return ""
-		}
-		if next, ok := current[key].(map[string]interface{}); ok {
-			current = next
-		} else {
-			return ""
-		}
-	}
-	return ""
+func GetAthlete(sport string, league string, athleteID string) (*AthleteProfile, error) {
+	return DefaultProvider.Athlete(context.Background(), sport, league, athleteID)
 }
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	// standingsPath is the ESPN standings API prefix, relative to
+	// Client.BaseURL. Standings are not served under the site API.
+	standingsPath = "/apis/v2/sports"
+)
+
+// Standings is a league table, split into the groups ESPN reports such as
+// conferences and divisions.
+type Standings struct {
+	Sport  string
+	League string
+	Name   string
+	Groups []StandingsGroup
+}
+
+// StandingsGroup is a conference, division or whole-league table. A group
+// has either its own Entries, nested Groups, or both.
+type StandingsGroup struct {
+	Name         string
+	Abbreviation string
+	Entries      []StandingsEntry
+	Groups       []StandingsGroup
+}
+
+// StandingsEntry is one team's line in a standings table. Fields that do
+// not apply to a sport are left at their zero value.
+type StandingsEntry struct {
+	TeamID        string
+	Team          string
+	Abbreviation  string
+	GamesPlayed   int
+	Wins          int
+	Losses        int
+	Ties          int
+	OTLosses      int
+	WinPercent    float64
+	GamesBehind   float64
+	Streak        string
+	Points        int
+	PointsFor     int
+	PointsAgainst int
+	Differential  float64
+	Seed          int
+}
+
+type espnStandingsGroup struct {
+	Name         string               `json:"name"`
+	Abbreviation string               `json:"abbreviation"`
+	Children     []espnStandingsGroup `json:"children"`
+	Standings    struct {
+		Entries []struct {
+			Team struct {
+				ID           string `json:"id"`
+				DisplayName  string `json:"displayName"`
+				Abbreviation string `json:"abbreviation"`
+			} `json:"team"`
+			Stats []struct {
+				Name         string  `json:"name"`
+				Type         string  `json:"type"`
+				DisplayValue string  `json:"displayValue"`
+				Value        float64 `json:"value"`
+			} `json:"stats"`
+		} `json:"entries"`
+	} `json:"standings"`
+}
+
+// Standings fetches the league standings from ESPN.
+func (p *ESPNProvider) Standings(ctx context.Context, sport string, league string) (*Standings, error) {
+	path := fmt.Sprintf("%s/%s/%s/standings", standingsPath, sport, league)
+
+	body, stale, err := p.client.get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch standings: %w", err)
+	}
+
+	var root espnStandingsGroup
+	if err := json.Unmarshal(body, &root); err != nil {
+		return nil, parseError(err)
+	}
+
+	standings := &Standings{
+		Sport:  sport,
+		League: league,
+		Name:   root.Name,
+	}
+
+	// Some leagues (most soccer) report a single table at the top level
+	// rather than conference children.
+	top := parseStandingsGroup(root)
+	if len(top.Entries) > 0 {
+		standings.Groups = append(standings.Groups, StandingsGroup{
+			Name:         top.Name,
+			Abbreviation: top.Abbreviation,
+			Entries:      top.Entries,
+		})
+	}
+	standings.Groups = append(standings.Groups, top.Groups...)
+
+	return standings, stale
+}
+
+func parseStandingsGroup(g espnStandingsGroup) StandingsGroup {
+	group := StandingsGroup{
+		Name:         g.Name,
+		Abbreviation: g.Abbreviation,
+	}
+
+	for _, e := range g.Standings.Entries {
+		entry := StandingsEntry{
+			TeamID:       e.Team.ID,
+			Team:         e.Team.DisplayName,
+			Abbreviation: e.Team.Abbreviation,
+		}
+
+		for _, stat := range e.Stats {
+			name := stat.Name
+			if name == "" {
+				name = stat.Type
+			}
+			value := int(stat.Value)
+
+			switch strings.ToLower(name) {
+			case "gamesplayed":
+				entry.GamesPlayed = value
+			case "wins":
+				entry.Wins = value
+			case "losses":
+				entry.Losses = value
+			case "ties":
+				entry.Ties = value
+			case "otlosses", "overtimelosses":
+				entry.OTLosses = value
+			case "winpercent":
+				entry.WinPercent = stat.Value
+			case "gamesbehind":
+				entry.GamesBehind = stat.Value
+			case "streak":
+				entry.Streak = stat.DisplayValue
+			case "points":
+				entry.Points = value
+			case "pointsfor":
+				entry.PointsFor = value
+			case "pointsagainst":
+				entry.PointsAgainst = value
+			case "pointdifferential", "differential":
+				entry.Differential = stat.Value
+			case "playoffseed":
+				entry.Seed = value
+			}
+		}
+
+		group.Entries = append(group.Entries, entry)
+	}
+
+	for _, child := range g.Children {
+		group.Groups = append(group.Groups, parseStandingsGroup(child))
+	}
+
+	return group
+}
@@ -0,0 +1,484 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// summaryPlays is how many of a game's latest plays GameDetail keeps.
+const summaryPlays = 20
+
+// espnSummary is the part of ESPN's game summary payload sportsterminal
+// reads. Sports fill in different parts of it: soccer has keyEvents where
+// the others have plays, and odds and injuries are often missing.
+type espnSummary struct {
+	Header struct {
+		Competitions []struct {
+			Status struct {
+				Period       espnText `json:"period"`
+				DisplayClock string   `json:"displayClock"`
+				Type         struct {
+					State       string `json:"state"`
+					Description string `json:"description"`
+					Detail      string `json:"detail"`
+				} `json:"type"`
+			} `json:"status"`
+			Venue struct {
+				FullName string `json:"fullName"`
+			} `json:"venue"`
+			Attendance  espnText                `json:"attendance"`
+			Competitors []espnSummaryCompetitor `json:"competitors"`
+		} `json:"competitions"`
+	} `json:"header"`
+	Boxscore struct {
+		Teams []struct {
+			Team       espnSummaryTeam `json:"team"`
+			Statistics []struct {
+				Label        string   `json:"label"`
+				DisplayValue espnText `json:"displayValue"`
+			} `json:"statistics"`
+		} `json:"teams"`
+		Players []struct {
+			Team       espnSummaryTeam `json:"team"`
+			Statistics []struct {
+				Name     string     `json:"name"`
+				Text     string     `json:"text"`
+				Labels   []espnText `json:"labels"`
+				Totals   []espnText `json:"totals"`
+				Athletes []struct {
+					Athlete    espnSummaryAthlete `json:"athlete"`
+					Starter    bool               `json:"starter"`
+					DidNotPlay bool               `json:"didNotPlay"`
+					Reason     string             `json:"reason"`
+					Stats      []espnText         `json:"stats"`
+				} `json:"athletes"`
+			} `json:"statistics"`
+		} `json:"players"`
+	} `json:"boxscore"`
+	Plays     []espnSummaryPlay `json:"plays"`
+	KeyEvents []espnSummaryPlay `json:"keyEvents"`
+	Leaders   []struct {
+		Team    espnSummaryTeam `json:"team"`
+		Leaders []struct {
+			Name        string `json:"name"`
+			DisplayName string `json:"displayName"`
+			Leaders     []struct {
+				DisplayValue espnText           `json:"displayValue"`
+				Athlete      espnSummaryAthlete `json:"athlete"`
+			} `json:"leaders"`
+		} `json:"leaders"`
+	} `json:"leaders"`
+	GameInfo struct {
+		Venue struct {
+			FullName string `json:"fullName"`
+		} `json:"venue"`
+		Attendance espnText `json:"attendance"`
+	} `json:"gameInfo"`
+	Pickcenter []espnSummaryOdds `json:"pickcenter"`
+	Odds       []espnSummaryOdds `json:"odds"`
+	Injuries   []struct {
+		Team     espnSummaryTeam `json:"team"`
+		Injuries []struct {
+			Status  string             `json:"status"`
+			Athlete espnSummaryAthlete `json:"athlete"`
+			Details struct {
+				Type   string `json:"type"`
+				Detail string `json:"detail"`
+			} `json:"details"`
+			Type struct {
+				Description string `json:"description"`
+			} `json:"type"`
+		} `json:"injuries"`
+	} `json:"injuries"`
+}
+
+type espnSummaryCompetitor struct {
+	ID         espnText        `json:"id"`
+	HomeAway   string          `json:"homeAway"`
+	Score      espnText        `json:"score"`
+	Team       espnSummaryTeam `json:"team"`
+	Linescores []struct {
+		DisplayValue espnText `json:"displayValue"`
+		Value        espnText `json:"value"`
+	} `json:"linescores"`
+	Hits   espnText `json:"hits"`
+	Errors espnText `json:"errors"`
+
+	// The summary header names it record; older payloads used records
+	Record  []espnSummaryRecord `json:"record"`
+	Records []espnSummaryRecord `json:"records"`
+}
+
+type espnSummaryRecord struct {
+	Summary string `json:"summary"`
+}
+
+type espnSummaryTeam struct {
+	ID               espnText `json:"id"`
+	DisplayName      string   `json:"displayName"`
+	ShortDisplayName string   `json:"shortDisplayName"`
+	Abbreviation     string   `json:"abbreviation"`
+	Logo             string   `json:"logo"`
+	Logos            []struct {
+		Href string `json:"href"`
+	} `json:"logos"`
+}
+
+type espnSummaryAthlete struct {
+	ID          espnText `json:"id"`
+	DisplayName string   `json:"displayName"`
+	FullName    string   `json:"fullName"`
+	ShortName   string   `json:"shortName"`
+	Jersey      espnText `json:"jersey"`
+	Position    struct {
+		Abbreviation string `json:"abbreviation"`
+	} `json:"position"`
+}
+
+// name returns the athlete's display name, falling back to the full and
+// short names.
+func (a espnSummaryAthlete) name() string {
+	for _, name := range []string{a.DisplayName, a.FullName, a.ShortName} {
+		if name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+type espnSummaryPlay struct {
+	Text        string `json:"text"`
+	ScoringPlay bool   `json:"scoringPlay"`
+	Period      struct {
+		Number       espnText `json:"number"`
+		DisplayValue string   `json:"displayValue"`
+	} `json:"period"`
+	Clock struct {
+		DisplayValue string `json:"displayValue"`
+	} `json:"clock"`
+	Type struct {
+		Text string `json:"text"`
+	} `json:"type"`
+	Team espnSummaryTeam `json:"team"`
+}
+
+type espnSummaryOdds struct {
+	Details      string   `json:"details"`
+	Spread       espnText `json:"spread"`
+	OverUnder    espnText `json:"overUnder"`
+	HomeTeamOdds struct {
+		Favorite bool `json:"favorite"`
+	} `json:"homeTeamOdds"`
+	AwayTeamOdds struct {
+		Favorite bool `json:"favorite"`
+	} `json:"awayTeamOdds"`
+}
+
+// espnText decodes a value the summary sends as a string in one sport and
+// a number, or an object with a displayValue, in another. Anything else
+// decodes as empty rather than failing the whole summary.
+type espnText string
+
+func (t *espnText) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	switch v := value.(type) {
+	case string:
+		*t = espnText(v)
+	case float64:
+		*t = espnText(strconv.FormatFloat(v, 'f', -1, 64))
+	case bool:
+		*t = espnText(strconv.FormatBool(v))
+	case map[string]interface{}:
+		display, _ := v["displayValue"].(string)
+		*t = espnText(display)
+	default:
+		*t = ""
+	}
+	return nil
+}
+
+func (t espnText) float() float64 {
+	f, _ := strconv.ParseFloat(string(t), 64)
+	return f
+}
+
+func texts(values []espnText) []string {
+	if values == nil {
+		return nil
+	}
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = string(v)
+	}
+	return strs
+}
+
+// parseSummary converts a summary payload into a GameDetail. Fields of an
+// unexpected type are skipped rather than failing the summary; only a body
+// that isn't a JSON object is an error.
+func parseSummary(eventID string, body []byte) (*GameDetail, error) {
+	var s espnSummary
+	if err := json.Unmarshal(body, &s); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if !errors.As(err, &typeErr) || typeErr.Field == "" {
+			return nil, parseError(err)
+		}
+		// The rest of the payload was decoded regardless
+	}
+
+	detail := &GameDetail{ID: eventID}
+
+	if len(s.Header.Competitions) > 0 {
+		comp := s.Header.Competitions[0]
+		detail.Status = comp.Status.Type.Description
+		detail.StatusDetail = comp.Status.Type.Detail
+		detail.State = comp.Status.Type.State
+		detail.IsLive = comp.Status.Type.State == "in"
+		detail.Period = string(comp.Status.Period)
+		detail.Clock = comp.Status.DisplayClock
+		detail.Venue = comp.Venue.FullName
+		detail.Attendance = string(comp.Attendance)
+
+		for _, competitor := range comp.Competitors {
+			if competitor.HomeAway == "home" {
+				detail.HomeTeam = competitor.toTeamDetail()
+			} else {
+				detail.AwayTeam = competitor.toTeamDetail()
+			}
+		}
+	}
+
+	// The summary header carries no event name
+	if detail.AwayTeam.Name != "" && detail.HomeTeam.Name != "" {
+		detail.Name = fmt.Sprintf("%s at %s", detail.AwayTeam.Name, detail.HomeTeam.Name)
+	}
+
+	// gameInfo is where most sports report the venue and attendance
+	if detail.Venue == "" {
+		detail.Venue = s.GameInfo.Venue.FullName
+	}
+	if detail.Attendance == "" {
+		detail.Attendance = string(s.GameInfo.Attendance)
+	}
+	if detail.Attendance == "0" {
+		detail.Attendance = ""
+	}
+
+	for _, team := range s.Boxscore.Teams {
+		stats := []Statistic{}
+		for _, stat := range team.Statistics {
+			stats = append(stats, Statistic{Label: stat.Label, Value: string(stat.DisplayValue)})
+		}
+		if side := detail.side(team.Team); side != nil {
+			side.Statistics = stats
+		}
+	}
+
+	// Per-player box score tables, one per statistics element (passing,
+	// batting, goalies...), with a row per athlete and a totals row
+	for _, team := range s.Boxscore.Players {
+		var groups []BoxScoreGroup
+		for _, stat := range team.Statistics {
+			group := BoxScoreGroup{
+				Name:   stat.Text,
+				Labels: texts(stat.Labels),
+				Totals: texts(stat.Totals),
+			}
+			if group.Name == "" {
+				group.Name = stat.Name
+			}
+			for _, entry := range stat.Athletes {
+				group.Players = append(group.Players, BoxScorePlayer{
+					AthleteID:  string(entry.Athlete.ID),
+					Name:       entry.Athlete.DisplayName,
+					ShortName:  entry.Athlete.ShortName,
+					Jersey:     string(entry.Athlete.Jersey),
+					Position:   entry.Athlete.Position.Abbreviation,
+					Starter:    entry.Starter,
+					DidNotPlay: entry.DidNotPlay,
+					Reason:     entry.Reason,
+					Stats:      texts(entry.Stats),
+				})
+			}
+			groups = append(groups, group)
+		}
+		if side := detail.side(team.Team); side != nil {
+			side.BoxScore = groups
+		}
+	}
+
+	plays := s.Plays
+	if len(plays) == 0 {
+		plays = s.KeyEvents
+	}
+	detail.Plays = detail.latestPlays(plays)
+
+	for _, team := range s.Leaders {
+		teamName, teamID := team.Team.DisplayName, string(team.Team.ID)
+		for _, category := range team.Leaders {
+			// The first leader is the category's top performer
+			if len(category.Leaders) == 0 || category.Leaders[0].Athlete.name() == "" {
+				continue
+			}
+			top := category.Leaders[0]
+			name := category.DisplayName
+			if name == "" {
+				name = category.Name
+			}
+			detail.Leaders = append(detail.Leaders, Leader{
+				Category:  name,
+				Athlete:   top.Athlete.name(),
+				AthleteID: string(top.Athlete.ID),
+				Team:      teamName,
+				TeamID:    teamID,
+				Value:     string(top.DisplayValue),
+			})
+		}
+	}
+
+	odds := s.Pickcenter
+	if len(odds) == 0 {
+		odds = s.Odds
+	}
+	if len(odds) > 0 && odds[0].Details != "" {
+		detail.Odds = odds[0].toOdds(detail)
+	}
+
+	for _, team := range s.Injuries {
+		for _, injury := range team.Injuries {
+			if injury.Athlete.name() == "" {
+				continue
+			}
+			reason := injury.Details.Type
+			if injury.Details.Detail != "" {
+				reason = injury.Details.Detail
+			}
+			if reason == "" {
+				reason = injury.Type.Description
+			}
+			detail.Injuries = append(detail.Injuries, Injury{
+				TeamID:    string(team.Team.ID),
+				Team:      team.Team.DisplayName,
+				AthleteID: string(injury.Athlete.ID),
+				Athlete:   injury.Athlete.name(),
+				Position:  injury.Athlete.Position.Abbreviation,
+				Status:    injury.Status,
+				Detail:    reason,
+			})
+		}
+	}
+
+	return detail, nil
+}
+
+func (c espnSummaryCompetitor) toTeamDetail() TeamDetail {
+	td := TeamDetail{
+		ID:           string(c.Team.ID),
+		Name:         c.Team.DisplayName,
+		ShortName:    c.Team.ShortDisplayName,
+		Abbreviation: c.Team.Abbreviation,
+		Logo:         c.Team.Logo,
+		Score:        string(c.Score),
+		Hits:         string(c.Hits),
+		Errors:       string(c.Errors),
+	}
+	if td.ID == "" {
+		td.ID = string(c.ID)
+	}
+	if td.Logo == "" && len(c.Team.Logos) > 0 {
+		td.Logo = c.Team.Logos[0].Href
+	}
+	for _, ls := range c.Linescores {
+		value := string(ls.DisplayValue)
+		if value == "" {
+			value = string(ls.Value)
+		}
+		td.LineScores = append(td.LineScores, value)
+	}
+
+	records := c.Record
+	if len(records) == 0 {
+		records = c.Records
+	}
+	if len(records) > 0 {
+		td.Record = records[0].Summary
+	}
+	return td
+}
+
+// side returns the home or away team matching team, by ID or else by
+// name, or nil when it is neither.
+func (d *GameDetail) side(team espnSummaryTeam) *TeamDetail {
+	for _, side := range []*TeamDetail{&d.HomeTeam, &d.AwayTeam} {
+		if team.ID != "" && string(team.ID) == side.ID {
+			return side
+		}
+	}
+	for _, side := range []*TeamDetail{&d.HomeTeam, &d.AwayTeam} {
+		if team.DisplayName != "" && team.DisplayName == side.Name {
+			return side
+		}
+	}
+	return nil
+}
+
+// latestPlays returns the last summaryPlays significant plays, oldest
+// first. Plays often name their team by ID only, so the team's short name
+// is looked up from the header.
+func (d *GameDetail) latestPlays(plays []espnSummaryPlay) []Play {
+	var latest []Play
+	for i := len(plays) - 1; i >= 0 && len(latest) < summaryPlays; i-- {
+		play := plays[i]
+		if play.Text == "" || !play.ScoringPlay && play.Type.Text == "" {
+			continue
+		}
+
+		period := play.Period.DisplayValue
+		if period == "" {
+			period = string(play.Period.Number)
+		}
+		team := play.Team.ShortDisplayName
+		if team == "" {
+			if side := d.side(play.Team); side != nil {
+				team = side.ShortName
+				if team == "" {
+					team = side.Name
+				}
+			}
+		}
+
+		latest = append(latest, Play{
+			Period:      period,
+			Clock:       play.Clock.DisplayValue,
+			Text:        play.Text,
+			ScoringPlay: play.ScoringPlay,
+			Team:        team,
+		})
+	}
+
+	for i, j := 0, len(latest)-1; i < j; i, j = i+1, j-1 {
+		latest[i], latest[j] = latest[j], latest[i]
+	}
+	return latest
+}
+
+func (o espnSummaryOdds) toOdds(detail *GameDetail) *Odds {
+	odds := &Odds{Details: o.Details, Spread: o.Spread.float(), OverUnder: o.OverUnder.float()}
+	switch {
+	case o.HomeTeamOdds.Favorite:
+		odds.Favorite = "home"
+	case o.AwayTeamOdds.Favorite:
+		odds.Favorite = "away"
+	default:
+		odds.Favorite = oddsFavorite(o.Details, Game{
+			HomeTeam: Team{Abbreviation: detail.HomeTeam.Abbreviation},
+			AwayTeam: Team{Abbreviation: detail.AwayTeam.Abbreviation},
+		})
+	}
+	return odds
+}
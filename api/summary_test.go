@@ -0,0 +1,272 @@
+package api
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func readSummary(t *testing.T, name string) *GameDetail {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", "summary", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	detail, err := parseSummary("1", body)
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	return detail
+}
+
+// playTexts returns each play as "period clock team: text".
+func playTexts(plays []Play) []string {
+	var texts []string
+	for _, play := range plays {
+		texts = append(texts, play.Period+" "+play.Clock+" "+play.Team+": "+play.Text)
+	}
+	return texts
+}
+
+func TestParseSummaryFootball(t *testing.T) {
+	d := readSummary(t, "football-nfl.json")
+
+	if d.Name != "Baltimore Ravens at Kansas City Chiefs" || d.State != "in" || !d.IsLive || d.Period != "3" || d.Clock != "5:32" {
+		t.Errorf("header = %q, %s, live %v, period %s, clock %s", d.Name, d.State, d.IsLive, d.Period, d.Clock)
+	}
+	if d.Venue != "GEHA Field at Arrowhead Stadium" || d.Attendance != "73000" {
+		t.Errorf("venue = %q, attendance %q", d.Venue, d.Attendance)
+	}
+	if d.HomeTeam.ID != "12" || d.HomeTeam.Abbreviation != "KC" || d.HomeTeam.Record != "0-0" || d.HomeTeam.Logo == "" {
+		t.Errorf("home team = %+v", d.HomeTeam)
+	}
+	if want := []string{"10", "7", "7"}; !reflect.DeepEqual(d.AwayTeam.LineScores, want) {
+		t.Errorf("away line scores = %q, want %q", d.AwayTeam.LineScores, want)
+	}
+	// Statistics are matched to teams by ID, whatever their order
+	if len(d.AwayTeam.Statistics) != 2 || d.AwayTeam.Statistics[0].Value != "301" {
+		t.Errorf("away statistics = %+v", d.AwayTeam.Statistics)
+	}
+	if len(d.HomeTeam.BoxScore) != 1 || d.HomeTeam.BoxScore[0].Name != "Kansas City Passing" ||
+		d.HomeTeam.BoxScore[0].Players[0].Jersey != "15" {
+		t.Errorf("home box score = %+v", d.HomeTeam.BoxScore)
+	}
+
+	// The timeout has no type and isn't shown; plays by team ID get the
+	// team's short name
+	wantPlays := []string{
+		"1 15:00 Chiefs: H.Butker kicks 65 yards from KC 35 to end zone, Touchback.",
+		"3 6:01 Ravens: D.Henry 3 yd run (J.Tucker kick)",
+	}
+	if got := playTexts(d.Plays); !reflect.DeepEqual(got, wantPlays) {
+		t.Errorf("plays = %q, want %q", got, wantPlays)
+	}
+
+	// The empty rushing category is skipped
+	wantLeaders := []Leader{
+		{Category: "Passing Yards", Team: "Kansas City Chiefs", TeamID: "12", Athlete: "Patrick Mahomes", AthleteID: "3139477", Value: "20/30, 250 YDS, 2 TD"},
+		{Category: "Rushing Yards", Team: "Baltimore Ravens", TeamID: "33", Athlete: "Derrick Henry", AthleteID: "3043078", Value: "18 CAR, 104 YDS, 1 TD"},
+	}
+	if !reflect.DeepEqual(d.Leaders, wantLeaders) {
+		t.Errorf("leaders = %+v, want %+v", d.Leaders, wantLeaders)
+	}
+
+	if want := (&Odds{Details: "KC -3", Spread: -3, OverUnder: 46.5, Favorite: "home"}); !reflect.DeepEqual(d.Odds, want) {
+		t.Errorf("odds = %+v, want %+v", d.Odds, want)
+	}
+
+	wantInjuries := []Injury{
+		{TeamID: "12", Team: "Kansas City Chiefs", AthleteID: "4361529", Athlete: "Isiah Pacheco", Position: "RB", Status: "Out", Detail: "Fibula"},
+		{TeamID: "12", Team: "Kansas City Chiefs", AthleteID: "15847", Athlete: "Travis Kelce", Position: "TE", Status: "Questionable", Detail: "questionable"},
+	}
+	if !reflect.DeepEqual(d.Injuries, wantInjuries) {
+		t.Errorf("injuries = %+v, want %+v", d.Injuries, wantInjuries)
+	}
+}
+
+func TestParseSummaryBasketball(t *testing.T) {
+	d := readSummary(t, "basketball-nba.json")
+
+	if d.Status != "Final" || d.State != "post" || d.IsLive || d.HomeTeam.Score != "110" || d.AwayTeam.Score != "99" {
+		t.Errorf("header = %s, %s, live %v, %s-%s", d.Status, d.State, d.IsLive, d.AwayTeam.Score, d.HomeTeam.Score)
+	}
+	if d.HomeTeam.Record != "25-5" || len(d.HomeTeam.LineScores) != 4 {
+		t.Errorf("home team = %+v", d.HomeTeam)
+	}
+	if d.Attendance != "19156" || d.Venue != "TD Garden" {
+		t.Errorf("venue = %q, attendance %q", d.Venue, d.Attendance)
+	}
+
+	// Basketball has a single unnamed box score group
+	box := d.HomeTeam.BoxScore
+	if len(box) != 1 || box[0].Name != "" || len(box[0].Players) != 2 {
+		t.Fatalf("home box score = %+v", box)
+	}
+	if p := box[0].Players[1]; !p.DidNotPlay || p.Reason != "COACH'S DECISION" || p.Starter {
+		t.Errorf("bench player = %+v", p)
+	}
+
+	wantPlays := []string{
+		"1st Quarter 12:00 Knicks: Al Horford vs. Mitchell Robinson (Jalen Brunson gains possession)",
+		"4th Quarter 2:31 Celtics: Jayson Tatum makes 26-foot three point jumper",
+		"4th Quarter 0.0 : End of Game",
+	}
+	if got := playTexts(d.Plays); !reflect.DeepEqual(got, wantPlays) {
+		t.Errorf("plays = %q, want %q", got, wantPlays)
+	}
+	if len(d.Leaders) != 2 || d.Leaders[0].Value != "31" {
+		t.Errorf("leaders = %+v", d.Leaders)
+	}
+	if d.Odds != nil {
+		t.Errorf("odds = %+v, want none", d.Odds)
+	}
+	if len(d.Injuries) != 1 || d.Injuries[0].Detail != "Ankle" || d.Injuries[0].Status != "Day-To-Day" {
+		t.Errorf("injuries = %+v", d.Injuries)
+	}
+}
+
+func TestParseSummaryCollegeBasketball(t *testing.T) {
+	d := readSummary(t, "basketball-mens-college-basketball.json")
+
+	if d.Period != "2" || d.StatusDetail != "11:42 - 2nd Half" || !d.IsLive {
+		t.Errorf("header = period %s, %q, live %v", d.Period, d.StatusDetail, d.IsLive)
+	}
+	if want := []string{"32", "22"}; !reflect.DeepEqual(d.AwayTeam.LineScores, want) {
+		t.Errorf("away line scores = %q, want the two halves %q", d.AwayTeam.LineScores, want)
+	}
+	if d.HomeTeam.BoxScore != nil {
+		t.Errorf("home box score = %+v, want none", d.HomeTeam.BoxScore)
+	}
+	if want := (&Odds{Details: "DUKE -10.5", Spread: 10.5, OverUnder: 151.5, Favorite: "away"}); !reflect.DeepEqual(d.Odds, want) {
+		t.Errorf("odds = %+v, want %+v", d.Odds, want)
+	}
+	if got := playTexts(d.Plays); len(got) != 2 || got[0] != "2nd Half 11:58 Duke: Cooper Flagg made Layup." {
+		t.Errorf("plays = %q", got)
+	}
+}
+
+func TestParseSummaryBaseball(t *testing.T) {
+	d := readSummary(t, "baseball-mlb.json")
+
+	if d.HomeTeam.Hits != "9" || d.HomeTeam.Errors != "0" || d.AwayTeam.Hits != "7" || d.AwayTeam.Errors != "1" {
+		t.Errorf("hits and errors = %s/%s, %s/%s", d.AwayTeam.Hits, d.AwayTeam.Errors, d.HomeTeam.Hits, d.HomeTeam.Errors)
+	}
+	// Innings fall back to the numeric value when there is no display value
+	if want := []string{"1", "0", "4", "0", "0", "0", "0", "0", "X"}; !reflect.DeepEqual(d.HomeTeam.LineScores, want) {
+		t.Errorf("home line scores = %q, want %q", d.HomeTeam.LineScores, want)
+	}
+	if got := len(d.HomeTeam.BoxScore); got != 2 {
+		t.Errorf("home box score has %d groups, want batting and pitching", got)
+	}
+
+	wantPlays := []string{
+		"1st Inning  : Top of the 1st inning",
+		"3rd Inning  Yankees: Judge homered to center (412 feet), Soto scored.",
+	}
+	if got := playTexts(d.Plays); !reflect.DeepEqual(got, wantPlays) {
+		t.Errorf("plays = %q, want %q", got, wantPlays)
+	}
+
+	// odds stands in for a missing pickcenter; the favorite comes from
+	// the details
+	if want := (&Odds{Details: "NYY -150", OverUnder: 8.5, Favorite: "home"}); !reflect.DeepEqual(d.Odds, want) {
+		t.Errorf("odds = %+v, want %+v", d.Odds, want)
+	}
+}
+
+func TestParseSummaryHockey(t *testing.T) {
+	d := readSummary(t, "hockey-nhl.json")
+
+	// A numeric score, records under "records" and attendance as text
+	if d.HomeTeam.Score != "3" || d.HomeTeam.Record != "10-2-1" || d.Attendance != "17,850" {
+		t.Errorf("home score %q, record %q, attendance %q", d.HomeTeam.Score, d.HomeTeam.Record, d.Attendance)
+	}
+	if d.StatusDetail != "Final/OT" || len(d.HomeTeam.LineScores) != 4 {
+		t.Errorf("status %q, line scores %q", d.StatusDetail, d.HomeTeam.LineScores)
+	}
+	if got := playTexts(d.Plays); len(got) != 2 || got[1] != "OT 2:14 Bruins: David Pastrnak Goal (12) Wrist Shot, assists: Charlie McAvoy (9)" {
+		t.Errorf("plays = %q", got)
+	}
+}
+
+func TestParseSummarySoccer(t *testing.T) {
+	d := readSummary(t, "soccer-eng.1.json")
+
+	// Soccer summaries carry key events instead of plays
+	wantPlays := []string{
+		"1 23' Arsenal: Goal! Arsenal 1, Liverpool 0. Bukayo Saka (Arsenal) right footed shot.",
+		"2 55' Liverpool: Virgil van Dijk (Liverpool) is shown the yellow card.",
+		"2 61' Liverpool: Goal! Arsenal 1, Liverpool 1. Mohamed Salah (Liverpool) left footed shot.",
+	}
+	if got := playTexts(d.Plays); !reflect.DeepEqual(got, wantPlays) {
+		t.Errorf("plays = %q, want %q", got, wantPlays)
+	}
+	if d.Clock != "67'" || d.Status != "Second Half" {
+		t.Errorf("clock %q, status %q", d.Clock, d.Status)
+	}
+	if len(d.HomeTeam.Statistics) != 2 || d.AwayTeam.Statistics[0].Value != "44.8" {
+		t.Errorf("statistics = %+v, %+v", d.HomeTeam.Statistics, d.AwayTeam.Statistics)
+	}
+	if d.Odds != nil || d.HomeTeam.LineScores != nil {
+		t.Errorf("odds %+v, line scores %q; want none", d.Odds, d.HomeTeam.LineScores)
+	}
+}
+
+func TestParseSummaryOddShapes(t *testing.T) {
+	t.Run("header array", func(t *testing.T) {
+		d := readSummary(t, "header-array.json")
+		if want := (&GameDetail{ID: "1"}); !reflect.DeepEqual(d, want) {
+			t.Errorf("detail = %+v, want an empty one", d)
+		}
+	})
+
+	t.Run("numeric IDs", func(t *testing.T) {
+		d := readSummary(t, "numeric-ids.json")
+		if d.HomeTeam.ID != "2" || d.AwayTeam.ID != "18" || d.HomeTeam.Score != "88" {
+			t.Errorf("teams = %+v, %+v", d.HomeTeam, d.AwayTeam)
+		}
+		if len(d.AwayTeam.Statistics) != 1 || d.AwayTeam.Statistics[0].Value != "47.5" {
+			t.Errorf("away statistics = %+v", d.AwayTeam.Statistics)
+		}
+		if got := playTexts(d.Plays); len(got) != 1 || got[0] != "4 1:02 Knicks: Mitchell Robinson dunk" {
+			t.Errorf("plays = %q", got)
+		}
+		want := []Leader{{Category: "Points", Team: "Boston Celtics", TeamID: "2", Athlete: "Jayson Tatum", AthleteID: "4065648", Value: "27"}}
+		if !reflect.DeepEqual(d.Leaders, want) {
+			t.Errorf("leaders = %+v, want %+v", d.Leaders, want)
+		}
+	})
+
+	t.Run("null athletes", func(t *testing.T) {
+		d := readSummary(t, "null-athletes.json")
+		if d.State != "pre" || d.Period != "0" {
+			t.Errorf("state %q, period %q", d.State, d.Period)
+		}
+		if len(d.HomeTeam.BoxScore) != 1 || d.HomeTeam.BoxScore[0].Players != nil {
+			t.Errorf("home box score = %+v", d.HomeTeam.BoxScore)
+		}
+		if d.Leaders != nil {
+			t.Errorf("leaders = %+v, want none", d.Leaders)
+		}
+		if len(d.Injuries) != 1 || d.Injuries[0].Athlete != "Jalen Brunson" {
+			t.Errorf("injuries = %+v, want only Brunson", d.Injuries)
+		}
+	})
+
+	t.Run("string spread", func(t *testing.T) {
+		d := readSummary(t, "string-spread.json")
+		want := &Odds{Details: "BOS -5.5", Spread: -5.5, OverUnder: 221.5, Favorite: "home"}
+		if !reflect.DeepEqual(d.Odds, want) {
+			t.Errorf("odds = %+v, want %+v", d.Odds, want)
+		}
+	})
+}
+
+func TestParseSummaryErrors(t *testing.T) {
+	for _, body := range []string{"", "[]", `{"header":`, "null x"} {
+		if _, err := parseSummary("1", []byte(body)); err == nil {
+			t.Errorf("parseSummary(%q) succeeded, want an error", body)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// TeamInfo is a team's profile: identity, record and standing.
+type TeamInfo struct {
+	ID           string
+	Name         string
+	ShortName    string
+	Abbreviation string
+	Location     string
+	Logo         string
+	Record       string
+	Standing     string
+	Records      []Statistic
+}
+
+type espnTeamResponse struct {
+	Team struct {
+		ID               string `json:"id"`
+		DisplayName      string `json:"displayName"`
+		ShortDisplayName string `json:"shortDisplayName"`
+		Abbreviation     string `json:"abbreviation"`
+		Location         string `json:"location"`
+		Logos            []struct {
+			Href string `json:"href"`
+		} `json:"logos"`
+		Record struct {
+			Items []struct {
+				Description string `json:"description"`
+				Type        string `json:"type"`
+				Summary     string `json:"summary"`
+			} `json:"items"`
+		} `json:"record"`
+		StandingSummary string `json:"standingSummary"`
+	} `json:"team"`
+}
+
+// Team fetches a team's profile and record from ESPN.
+func (p *ESPNProvider) Team(ctx context.Context, sport string, league string, teamID string) (*TeamInfo, error) {
+	path := fmt.Sprintf("%s/%s/%s/teams/%s", sitePath, sport, league, teamID)
+
+	body, stale, err := p.client.get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch team: %w", err)
+	}
+
+	var resp espnTeamResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, parseError(err)
+	}
+
+	t := resp.Team
+	info := &TeamInfo{
+		ID:           t.ID,
+		Name:         t.DisplayName,
+		ShortName:    t.ShortDisplayName,
+		Abbreviation: t.Abbreviation,
+		Location:     t.Location,
+		Standing:     t.StandingSummary,
+	}
+	if len(t.Logos) > 0 {
+		info.Logo = t.Logos[0].Href
+	}
+
+	for _, item := range t.Record.Items {
+		if item.Type == "total" && info.Record == "" {
+			info.Record = item.Summary
+		}
+		label := item.Description
+		if label == "" {
+			label = item.Type
+		}
+		info.Records = append(info.Records, Statistic{Label: label, Value: item.Summary})
+	}
+
+	return info, stale
+}
+
+// TeamSchedule fetches a team's season schedule, completed and upcoming,
+// sorted by start time.
+func (p *ESPNProvider) TeamSchedule(ctx context.Context, sport string, league string, teamID string) ([]Game, error) {
+	path := fmt.Sprintf("%s/%s/%s/teams/%s/schedule", sitePath, sport, league, teamID)
+
+	body, stale, err := p.client.get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch schedule: %w", err)
+	}
+
+	var espnResp ESPNResponse
+	if err := json.Unmarshal(body, &espnResp); err != nil {
+		return nil, parseError(err)
+	}
+
+	games := make([]Game, 0, len(espnResp.Events))
+	for _, event := range espnResp.Events {
+		if game, ok := parseEvent(event); ok {
+			games = append(games, game)
+		}
+	}
+
+	sort.Slice(games, func(i, j int) bool {
+		return games[i].Date.Before(games[j].Date)
+	})
+
+	return games, stale
+}
@@ -0,0 +1,140 @@
+// Package cli implements the non-interactive subcommands, which print to
+// stdout instead of starting the TUI.
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/elliota43/sportsterminal/api"
+	"github.com/elliota43/sportsterminal/config"
+	"github.com/elliota43/sportsterminal/notify"
+)
+
+// Env is what every subcommand runs against.
+type Env struct {
+	Provider api.Provider
+
+	// Sports is the league list subcommands resolve league names in,
+	// including any custom leagues from the config.
+	Sports []api.Sport
+
+	// ClockLayout formats times of day in human-readable output.
+	ClockLayout string
+
+	// Location is the time zone days and times are shown in.
+	Location *time.Location
+
+	// RefreshInterval is the default time between polls for commands
+	// that follow live games.
+	RefreshInterval time.Duration
+
+	// Notifications picks the events sent as desktop notifications, and
+	// Notifier sends them. Notifier is nil when none is available.
+	Notifications config.Notifications
+	Notifier      notify.Notifier
+
+	Stdout io.Writer
+	Stderr io.Writer
+}
+
+// ErrUsage marks errors caused by bad arguments rather than a failed
+// request. main exits with status 2 for them.
+var ErrUsage = errors.New("usage")
+
+// usageErr is a bad-arguments error matching ErrUsage.
+type usageErr struct {
+	msg string
+}
+
+func (e usageErr) Error() string { return e.msg }
+
+func (e usageErr) Unwrap() error { return ErrUsage }
+
+func usageError(format string, args ...interface{}) error {
+	return usageErr{msg: fmt.Sprintf(format, args...)}
+}
+
+// allowStale lets a command carry on with a result served from the cache
+// because fresh data couldn't be fetched, warning about its age on
+// stderr. Other errors are returned unchanged.
+func allowStale(env Env, err error) error {
+	since, ok := api.StaleSince(err)
+	if !ok {
+		return err
+	}
+	fmt.Fprintf(env.Stderr, "Warning: showing data from %s (%v)\n", since.In(env.Location).Format("Jan 2 "+env.ClockLayout), errors.Unwrap(err))
+	return nil
+}
+
+// Commands maps subcommand names to their implementations.
+var Commands = map[string]func(env Env, args []string) error{
+	"scores": Scores,
+	"game":   Game,
+	"watch":  Watch,
+}
+
+// parseInterspersed parses fs from args, allowing flags before, between
+// and after positional arguments. It returns the positional arguments.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// leagueName returns the display name of a league in sports.
+func leagueName(sports []api.Sport, ref api.LeagueRef) string {
+	for _, sport := range sports {
+		if sport.ID != ref.Sport {
+			continue
+		}
+		for _, league := range sport.Leagues {
+			if league.ID == ref.League {
+				return league.Name
+			}
+		}
+	}
+	return strings.ToUpper(ref.League)
+}
+
+// findLeague resolves a league given as "sport/league", a league ID such
+// as "nba" or "eng.1", or a league name such as "Premier League".
+func findLeague(sports []api.Sport, name string) (api.LeagueRef, error) {
+	if sport, league, ok := strings.Cut(name, "/"); ok {
+		return api.LeagueRef{Sport: sport, League: league}, nil
+	}
+
+	var matches []api.LeagueRef
+	for _, sport := range sports {
+		for _, league := range sport.Leagues {
+			if strings.EqualFold(league.ID, name) || strings.EqualFold(league.Name, name) {
+				matches = append(matches, api.LeagueRef{Sport: sport.ID, League: league.ID})
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return api.LeagueRef{}, usageError("unknown league %q (use an ID like nba or eng.1, or sport/league)", name)
+	case 1:
+		return matches[0], nil
+	default:
+		var options []string
+		for _, ref := range matches {
+			options = append(options, ref.Sport+"/"+ref.League)
+		}
+		return api.LeagueRef{}, usageError("league %q is ambiguous: %s", name, strings.Join(options, ", "))
+	}
+}
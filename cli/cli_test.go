@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/elliota43/sportsterminal/api"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// fakeProvider serves fixed games. Calls to the Provider methods it
+// doesn't implement panic.
+type fakeProvider struct {
+	api.Provider
+
+	games []api.Game
+	dates api.DateRange // the days last asked for
+}
+
+func (p *fakeProvider) Scoreboard(ctx context.Context, sport string, league string, dates api.DateRange) ([]api.Game, error) {
+	p.dates = dates
+	return p.games, nil
+}
+
+// run runs a command against provider and returns what it printed.
+func run(t *testing.T, command func(Env, []string) error, provider api.Provider, args ...string) string {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	env := Env{
+		Provider:    provider,
+		Sports:      api.AvailableSports,
+		ClockLayout: "3:04 PM",
+		Location:    time.UTC,
+		Stdout:      &stdout,
+		Stderr:      &stderr,
+	}
+	if err := command(env, args); err != nil {
+		t.Fatalf("%v: %v\n%s", args, err, stderr.String())
+	}
+	return stdout.String()
+}
+
+// golden compares got with the file testdata/name, rewriting the file
+// instead when the tests run with -update.
+func golden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("%s differs from the golden file:\n%s\nwant\n%s", name, got, want)
+	}
+}
@@ -0,0 +1,346 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/elliota43/sportsterminal/api"
+)
+
+// SummaryStat is a labelled team statistic in the game JSON output.
+type SummaryStat struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// SummaryPlayer is a player's line in a box score table.
+type SummaryPlayer struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Jersey     string   `json:"jersey"`
+	Position   string   `json:"position"`
+	Starter    bool     `json:"starter"`
+	DidNotPlay bool     `json:"did_not_play"`
+	Reason     string   `json:"reason"`
+	Stats      []string `json:"stats"`
+}
+
+// SummaryBoxScore is one of a team's box score tables, e.g. "passing".
+type SummaryBoxScore struct {
+	Name    string          `json:"name"`
+	Labels  []string        `json:"labels"`
+	Players []SummaryPlayer `json:"players"`
+	Totals  []string        `json:"totals"`
+}
+
+// SummaryTeam is one side of a game in the game JSON output.
+type SummaryTeam struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Short      string            `json:"short_name"`
+	Record     string            `json:"record"`
+	Score      string            `json:"score"`
+	LineScores []string          `json:"linescores"`
+	Hits       string            `json:"hits,omitempty"`
+	Errors     string            `json:"errors,omitempty"`
+	Statistics []SummaryStat     `json:"statistics"`
+	BoxScore   []SummaryBoxScore `json:"box_score"`
+}
+
+// SummaryLeader is a game leader in the game JSON output.
+type SummaryLeader struct {
+	Category  string `json:"category"`
+	Team      string `json:"team"`
+	Athlete   string `json:"athlete"`
+	AthleteID string `json:"athlete_id"`
+	Value     string `json:"value"`
+}
+
+// SummaryPlay is a play in the game JSON output.
+type SummaryPlay struct {
+	Period  string `json:"period"`
+	Clock   string `json:"clock"`
+	Team    string `json:"team"`
+	Text    string `json:"text"`
+	Scoring bool   `json:"scoring"`
+}
+
+// SummaryOdds is a game's betting line in the game JSON output.
+type SummaryOdds struct {
+	Details   string  `json:"details"`
+	Spread    float64 `json:"spread"`
+	OverUnder float64 `json:"over_under"`
+	Favorite  string  `json:"favorite"`
+}
+
+// SummaryInjury is a player on the injury report in the game JSON output.
+type SummaryInjury struct {
+	Team      string `json:"team"`
+	Athlete   string `json:"athlete"`
+	AthleteID string `json:"athlete_id"`
+	Position  string `json:"position"`
+	Status    string `json:"status"`
+	Detail    string `json:"detail"`
+}
+
+// GameSummary is the game command's JSON output, derived from
+// api.GameDetail. Its field names are part of the command's interface and
+// should only ever be added to.
+type GameSummary struct {
+	Sport      string          `json:"sport"`
+	League     string          `json:"league"`
+	ID         string          `json:"id"`
+	Name       string          `json:"name"`
+	Status     string          `json:"status"`
+	Detail     string          `json:"detail"`
+	Live       bool            `json:"live"`
+	Period     string          `json:"period"`
+	Clock      string          `json:"clock"`
+	Venue      string          `json:"venue"`
+	Attendance string          `json:"attendance"`
+	Away       SummaryTeam     `json:"away"`
+	Home       SummaryTeam     `json:"home"`
+	Leaders    []SummaryLeader `json:"leaders"`
+	Plays      []SummaryPlay   `json:"plays"`
+	Odds       *SummaryOdds    `json:"odds"`
+	Injuries   []SummaryInjury `json:"injuries"`
+}
+
+func newSummaryTeam(team api.TeamDetail) SummaryTeam {
+	t := SummaryTeam{
+		ID:         team.ID,
+		Name:       team.Name,
+		Short:      team.ShortName,
+		Record:     team.Record,
+		Score:      team.Score,
+		LineScores: append([]string{}, team.LineScores...),
+		Hits:       team.Hits,
+		Errors:     team.Errors,
+		Statistics: []SummaryStat{},
+		BoxScore:   []SummaryBoxScore{},
+	}
+	for _, stat := range team.Statistics {
+		t.Statistics = append(t.Statistics, SummaryStat{Label: stat.Label, Value: stat.Value})
+	}
+	for _, group := range team.BoxScore {
+		box := SummaryBoxScore{Name: group.Name, Labels: group.Labels, Totals: group.Totals, Players: []SummaryPlayer{}}
+		for _, p := range group.Players {
+			box.Players = append(box.Players, SummaryPlayer{
+				ID:         p.AthleteID,
+				Name:       p.Name,
+				Jersey:     p.Jersey,
+				Position:   p.Position,
+				Starter:    p.Starter,
+				DidNotPlay: p.DidNotPlay,
+				Reason:     p.Reason,
+				Stats:      p.Stats,
+			})
+		}
+		t.BoxScore = append(t.BoxScore, box)
+	}
+	return t
+}
+
+func newGameSummary(ref api.LeagueRef, detail *api.GameDetail) GameSummary {
+	s := GameSummary{
+		Sport:      ref.Sport,
+		League:     ref.League,
+		ID:         detail.ID,
+		Name:       detail.Name,
+		Status:     detail.Status,
+		Detail:     detail.StatusDetail,
+		Live:       detail.IsLive,
+		Period:     detail.Period,
+		Clock:      detail.Clock,
+		Venue:      detail.Venue,
+		Attendance: detail.Attendance,
+		Away:       newSummaryTeam(detail.AwayTeam),
+		Home:       newSummaryTeam(detail.HomeTeam),
+		Leaders:    []SummaryLeader{},
+		Plays:      []SummaryPlay{},
+		Injuries:   []SummaryInjury{},
+	}
+	for _, l := range detail.Leaders {
+		s.Leaders = append(s.Leaders, SummaryLeader{Category: l.Category, Team: l.Team, Athlete: l.Athlete, AthleteID: l.AthleteID, Value: l.Value})
+	}
+	for _, p := range detail.Plays {
+		s.Plays = append(s.Plays, SummaryPlay{Period: p.Period, Clock: p.Clock, Team: p.Team, Text: p.Text, Scoring: p.ScoringPlay})
+	}
+	if o := detail.Odds; o != nil {
+		s.Odds = &SummaryOdds{Details: o.Details, Spread: o.Spread, OverUnder: o.OverUnder, Favorite: o.Favorite}
+	}
+	for _, i := range detail.Injuries {
+		s.Injuries = append(s.Injuries, SummaryInjury{Team: i.Team, Athlete: i.Athlete, AthleteID: i.AthleteID, Position: i.Position, Status: i.Status, Detail: i.Detail})
+	}
+	return s
+}
+
+// Game implements "sportsterminal game <league> <eventID>", printing a
+// game's summary.
+func Game(env Env, args []string) error {
+	fs := flag.NewFlagSet("game", flag.ContinueOnError)
+	fs.SetOutput(env.Stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(env.Stderr, "Usage: sportsterminal game <league> <eventID> [--format text|json]")
+		fs.PrintDefaults()
+	}
+	format := fs.String("format", "text", "output format: text or json")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return usageError("%v", err)
+	}
+	if len(positional) != 2 {
+		fs.Usage()
+		return usageError("game takes a league and an event ID")
+	}
+
+	ref, err := findLeague(env.Sports, positional[0])
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(*format) {
+	case "text", "json":
+	default:
+		return usageError("unknown format %q (want text or json)", *format)
+	}
+
+	detail, err := env.Provider.Summary(context.Background(), ref.Sport, ref.League, positional[1])
+	if err := allowStale(env, err); err != nil {
+		return err
+	}
+
+	if strings.ToLower(*format) == "json" {
+		enc := json.NewEncoder(env.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(newGameSummary(ref, detail))
+	}
+	return writeGameText(env.Stdout, ref, detail)
+}
+
+func writeGameText(out io.Writer, ref api.LeagueRef, detail *api.GameDetail) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	away, home := detailName(detail.AwayTeam), detailName(detail.HomeTeam)
+
+	// Header
+	fmt.Fprintln(w, detail.Name)
+	status := detail.StatusDetail
+	if status == "" {
+		status = detail.Status
+	}
+	info := []string{status}
+	if detail.Venue != "" {
+		info = append(info, detail.Venue)
+	}
+	if detail.Attendance != "" {
+		info = append(info, "Attendance: "+detail.Attendance)
+	}
+	if detail.Odds != nil {
+		info = append(info, "Line: "+detail.Odds.Details)
+	}
+	fmt.Fprintln(w, strings.Join(info, " • "))
+	fmt.Fprintln(w)
+	for _, team := range []api.TeamDetail{detail.AwayTeam, detail.HomeTeam} {
+		if team.Record != "" {
+			fmt.Fprintf(w, "%s\t%s\t(%s)\n", team.Name, team.Score, team.Record)
+		} else {
+			fmt.Fprintf(w, "%s\t%s\n", team.Name, team.Score)
+		}
+	}
+
+	// Linescore
+	periods := len(detail.AwayTeam.LineScores)
+	if len(detail.HomeTeam.LineScores) > periods {
+		periods = len(detail.HomeTeam.LineScores)
+	}
+	if periods > 0 {
+		regulation := api.RegulationPeriods(ref.Sport, ref.League)
+		fmt.Fprintln(w, "\nLinescore")
+		header := []string{""}
+		for i := 0; i < periods; i++ {
+			header = append(header, api.PeriodLabel(ref.Sport, regulation, i))
+		}
+		if ref.Sport == "baseball" {
+			header = append(header, "R", "H", "E")
+		} else {
+			header = append(header, "T")
+		}
+		fmt.Fprintln(w, strings.Join(header, "\t"))
+		for _, team := range []api.TeamDetail{detail.AwayTeam, detail.HomeTeam} {
+			row := []string{detailName(team)}
+			for i := 0; i < periods; i++ {
+				value := ""
+				if i < len(team.LineScores) {
+					value = team.LineScores[i]
+				}
+				row = append(row, value)
+			}
+			row = append(row, team.Score)
+			if ref.Sport == "baseball" {
+				row = append(row, team.Hits, team.Errors)
+			}
+			fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+	}
+
+	// Leaders
+	if len(detail.Leaders) > 0 {
+		fmt.Fprintln(w, "\nLeaders")
+		for _, l := range detail.Leaders {
+			fmt.Fprintf(w, "%s\t%s (%s)\t%s\n", l.Category, l.Athlete, l.Team, l.Value)
+		}
+	}
+
+	// Team stats, matched by label
+	if len(detail.AwayTeam.Statistics) > 0 {
+		fmt.Fprintln(w, "\nTeam Stats")
+		fmt.Fprintf(w, "\t%s\t%s\n", away, home)
+		homeStats := map[string]string{}
+		for _, stat := range detail.HomeTeam.Statistics {
+			homeStats[stat.Label] = stat.Value
+		}
+		for _, stat := range detail.AwayTeam.Statistics {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", stat.Label, stat.Value, homeStats[stat.Label])
+		}
+	}
+
+	// Plays
+	if len(detail.Plays) > 0 {
+		fmt.Fprintln(w, "\nPlays")
+		for _, p := range detail.Plays {
+			text := p.Text
+			if p.ScoringPlay {
+				text += " *"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", p.Period, p.Clock, p.Team, text)
+		}
+	}
+
+	// Injury report
+	if len(detail.Injuries) > 0 {
+		fmt.Fprintln(w, "\nInjuries")
+		for _, i := range detail.Injuries {
+			fmt.Fprintf(w, "%s\t%s %s\t%s\t%s\n", i.Team, i.Athlete, i.Position, i.Status, i.Detail)
+		}
+	}
+
+	return w.Flush()
+}
+
+// detailName prefers a team's short name for compact output.
+func detailName(team api.TeamDetail) string {
+	if team.ShortName != "" {
+		return team.ShortName
+	}
+	return team.Name
+}
@@ -0,0 +1,194 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/elliota43/sportsterminal/api"
+)
+
+// ScoreTeam is one side of a game in the scores JSON output.
+type ScoreTeam struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Short  string `json:"short_name"`
+	Score  string `json:"score"`
+	Winner bool   `json:"winner"`
+}
+
+// ScoreGame is a game in the scores JSON output. Its field names are part
+// of the command's interface and should only ever be added to.
+type ScoreGame struct {
+	Sport     string    `json:"sport"`
+	League    string    `json:"league"`
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Date      time.Time `json:"date"`
+	State     string    `json:"state"`
+	Status    string    `json:"status"`
+	Detail    string    `json:"detail"`
+	Period    int       `json:"period"`
+	Clock     string    `json:"clock"`
+	Live      bool      `json:"live"`
+	Completed bool      `json:"completed"`
+	Venue     string    `json:"venue"`
+	Away      ScoreTeam `json:"away"`
+	Home      ScoreTeam `json:"home"`
+}
+
+func newScoreGame(ref api.LeagueRef, game api.Game) ScoreGame {
+	team := func(t api.Team) ScoreTeam {
+		return ScoreTeam{ID: t.ID, Name: t.Name, Short: t.ShortName, Score: t.Score, Winner: t.Winner}
+	}
+	return ScoreGame{
+		Sport:     ref.Sport,
+		League:    ref.League,
+		ID:        game.ID,
+		Name:      game.Name,
+		Date:      game.Date,
+		State:     game.State,
+		Status:    game.Status,
+		Detail:    game.StatusDetail,
+		Period:    game.Period,
+		Clock:     game.Clock,
+		Live:      game.IsLive,
+		Completed: game.Completed,
+		Venue:     game.Venue,
+		Away:      team(game.AwayTeam),
+		Home:      team(game.HomeTeam),
+	}
+}
+
+// Scores implements "sportsterminal scores <league>", printing a league's
+// scoreboard for a day or range of days.
+func Scores(env Env, args []string) error {
+	fs := flag.NewFlagSet("scores", flag.ContinueOnError)
+	fs.SetOutput(env.Stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(env.Stderr, "Usage: sportsterminal scores <league> [--date DAY] [--days N] [--live] [--format table|json|csv]")
+		fs.PrintDefaults()
+	}
+	date := fs.String("date", "today", "day to show: 2026-10-16, 10/16, yesterday, +3, ...")
+	days := fs.Int("days", 1, "number of days to show, starting at --date")
+	live := fs.Bool("live", false, "only show games in progress")
+	format := fs.String("format", "table", "output format: table, json or csv")
+
+	// Allow flags after the league name, as in "scores nba --format json"
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return usageError("%v", err)
+	}
+	if len(positional) != 1 {
+		fs.Usage()
+		return usageError("scores takes exactly one league")
+	}
+
+	ref, err := findLeague(env.Sports, positional[0])
+	if err != nil {
+		return err
+	}
+	start, err := api.ParseDay(*date, time.Now().In(env.Location))
+	if err != nil {
+		return usageError("%v", err)
+	}
+	if *days < 1 {
+		return usageError("--days must be at least 1")
+	}
+
+	games, err := env.Provider.Scoreboard(context.Background(), ref.Sport, ref.League, api.Days(start, start.AddDate(0, 0, *days-1)))
+	if err := allowStale(env, err); err != nil {
+		return err
+	}
+	if *live {
+		var inProgress []api.Game
+		for _, game := range games {
+			if game.IsLive {
+				inProgress = append(inProgress, game)
+			}
+		}
+		games = inProgress
+	}
+
+	switch strings.ToLower(*format) {
+	case "table", "text":
+		return writeScoresTable(env, games)
+	case "json":
+		return writeScoresJSON(env.Stdout, ref, games)
+	case "csv":
+		return writeScoresCSV(env.Stdout, ref, games)
+	default:
+		return usageError("unknown format %q (want table, json or csv)", *format)
+	}
+}
+
+func writeScoresTable(env Env, games []api.Game) error {
+	if len(games) == 0 {
+		_, err := fmt.Fprintln(env.Stdout, "No games found.")
+		return err
+	}
+
+	w := tabwriter.NewWriter(env.Stdout, 0, 0, 2, ' ', 0)
+	for _, game := range games {
+		status := game.StatusDetail
+		if status == "" {
+			status = game.Status
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t@\t%s\t%s\t%s\n",
+			game.Date.In(env.Location).Format("Mon Jan 2 "+env.ClockLayout),
+			teamName(game.AwayTeam), game.AwayTeam.Score,
+			teamName(game.HomeTeam), game.HomeTeam.Score,
+			status)
+	}
+	return w.Flush()
+}
+
+func writeScoresJSON(out io.Writer, ref api.LeagueRef, games []api.Game) error {
+	list := make([]ScoreGame, 0, len(games))
+	for _, game := range games {
+		list = append(list, newScoreGame(ref, game))
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(list)
+}
+
+func writeScoresCSV(out io.Writer, ref api.LeagueRef, games []api.Game) error {
+	w := csv.NewWriter(out)
+	w.Write([]string{"date", "sport", "league", "id", "state", "status", "away", "away_score", "home", "home_score", "venue"})
+	for _, game := range games {
+		w.Write([]string{
+			game.Date.Format(time.RFC3339),
+			ref.Sport,
+			ref.League,
+			game.ID,
+			game.State,
+			game.Status,
+			game.AwayTeam.Name,
+			game.AwayTeam.Score,
+			game.HomeTeam.Name,
+			game.HomeTeam.Score,
+			game.Venue,
+		})
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// teamName prefers a team's short name for compact output.
+func teamName(team api.Team) string {
+	if team.ShortName != "" {
+		return team.ShortName
+	}
+	return team.Name
+}
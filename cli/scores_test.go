@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/elliota43/sportsterminal/api"
+)
+
+// scoreboard is a day of NBA games: one final, one live and one not yet
+// started, whose scores are empty.
+var scoreboard = []api.Game{
+	{
+		ID:           "401585601",
+		Name:         "New York Knicks at Boston Celtics",
+		Date:         time.Date(2024, 3, 1, 0, 30, 0, 0, time.UTC),
+		Status:       "Final",
+		StatusDetail: "Final/OT",
+		State:        "post",
+		Period:       5,
+		Clock:        "0.0",
+		Completed:    true,
+		Venue:        "TD Garden",
+		AwayTeam:     api.Team{ID: "18", Name: "New York Knicks", ShortName: "Knicks", Score: "118"},
+		HomeTeam:     api.Team{ID: "2", Name: "Boston Celtics", ShortName: "Celtics", Score: "121", Winner: true},
+	},
+	{
+		ID:           "401585602",
+		Name:         "Los Angeles Lakers at Denver Nuggets",
+		Date:         time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC),
+		Status:       "In Progress",
+		StatusDetail: "5:12 - 3rd",
+		State:        "in",
+		Period:       3,
+		Clock:        "5:12",
+		IsLive:       true,
+		Venue:        "Ball Arena, Denver",
+		AwayTeam:     api.Team{ID: "13", Name: "Los Angeles Lakers", ShortName: "Lakers", Score: "71"},
+		HomeTeam:     api.Team{ID: "7", Name: "Denver Nuggets", ShortName: "Nuggets", Score: "80"},
+	},
+	{
+		ID:           "401585603",
+		Name:         "Golden State Warriors at LA Clippers",
+		Date:         time.Date(2024, 3, 1, 3, 30, 0, 0, time.UTC),
+		Status:       "Scheduled",
+		StatusDetail: "3/1 - 10:30 PM EST",
+		State:        "pre",
+		AwayTeam:     api.Team{ID: "9", Name: "Golden State Warriors", ShortName: "Warriors"},
+		HomeTeam:     api.Team{ID: "12", Name: "LA Clippers", ShortName: "Clippers"},
+	},
+}
+
+func TestScoresFormats(t *testing.T) {
+	for _, format := range []string{"json", "csv"} {
+		provider := &fakeProvider{games: scoreboard}
+		got := run(t, Scores, provider, "nba", "--date", "2024-03-01", "--format", format)
+		golden(t, "scores."+format, got)
+
+		if want := api.Day(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)); !provider.dates.Start.Equal(want.Start) || !provider.dates.End.Equal(want.End) {
+			t.Errorf("%s: asked for %v, want %v", format, provider.dates, want)
+		}
+	}
+}
+
+func TestScoresNoGames(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"json", "[]\n"},
+		{"csv", "date,sport,league,id,state,status,away,away_score,home,home_score,venue\n"},
+	}
+	for _, tt := range tests {
+		if got := run(t, Scores, &fakeProvider{}, "nba", "--format", tt.format); got != tt.want {
+			t.Errorf("%s: output = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
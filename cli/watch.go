@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/elliota43/sportsterminal/api"
+	"github.com/elliota43/sportsterminal/notify"
+	"github.com/elliota43/sportsterminal/watch"
+)
+
+// WatchEvent is a line of the watch command's JSON output. Its field names
+// are part of the command's interface and should only ever be added to.
+type WatchEvent struct {
+	Type   string    `json:"type"`
+	Time   time.Time `json:"time"`
+	Sport  string    `json:"sport"`
+	League string    `json:"league"`
+	GameID string    `json:"game_id"`
+	Name   string    `json:"name"`
+	Status string    `json:"status"`
+	Period int       `json:"period"`
+	Clock  string    `json:"clock"`
+	Scorer string    `json:"scorer,omitempty"`
+	Away   ScoreTeam `json:"away"`
+	Home   ScoreTeam `json:"home"`
+	Text   string    `json:"text"`
+}
+
+func newWatchEvent(at time.Time, ref api.LeagueRef, e watch.Event) WatchEvent {
+	game := newScoreGame(ref, e.Game)
+	return WatchEvent{
+		Type:   string(e.Type),
+		Time:   at,
+		Sport:  ref.Sport,
+		League: ref.League,
+		GameID: game.ID,
+		Name:   game.Name,
+		Status: game.Detail,
+		Period: game.Period,
+		Clock:  game.Clock,
+		Scorer: string(e.Scorer),
+		Away:   game.Away,
+		Home:   game.Home,
+		Text:   e.String(),
+	}
+}
+
+// Watch implements "sportsterminal watch <league>...", polling scoreboards
+// and printing each change as it happens until interrupted.
+func Watch(env Env, args []string) error {
+	fs := flag.NewFlagSet("watch", flag.ContinueOnError)
+	fs.SetOutput(env.Stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(env.Stderr, "Usage: sportsterminal watch <league>... [--all] [--interval 30s] [--format text|json]")
+		fs.PrintDefaults()
+	}
+	all := fs.Bool("all", false, "watch every league")
+	interval := fs.Duration("interval", env.RefreshInterval, "time between scoreboard polls")
+	format := fs.String("format", "text", "output format: text, or json for one event per line")
+	desktop := fs.Bool("notify", false, "also send desktop notifications, filtered by the config's notification rules")
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return usageError("%v", err)
+	}
+
+	var leagues []api.LeagueRef
+	if *all {
+		for _, sport := range env.Sports {
+			for _, league := range sport.Leagues {
+				leagues = append(leagues, api.LeagueRef{Sport: sport.ID, League: league.ID})
+			}
+		}
+	}
+	for _, name := range positional {
+		ref, err := findLeague(env.Sports, name)
+		if err != nil {
+			return err
+		}
+		leagues = append(leagues, ref)
+	}
+	if len(leagues) == 0 {
+		fs.Usage()
+		return usageError("watch needs at least one league, or --all")
+	}
+	if *interval <= 0 {
+		return usageError("--interval must be positive")
+	}
+
+	jsonOutput := false
+	switch strings.ToLower(*format) {
+	case "text":
+	case "json":
+		jsonOutput = true
+	default:
+		return usageError("unknown format %q (want text or json)", *format)
+	}
+
+	if *desktop && env.Notifier == nil {
+		return fmt.Errorf("--notify needs notify-send or gdbus")
+	}
+
+	fmt.Fprintf(env.Stderr, "Watching %d league(s) every %s; press Ctrl+C to stop.\n", len(leagues), *interval)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	enc := json.NewEncoder(env.Stdout)
+	snapshots := map[api.LeagueRef][]api.Game{}
+	leaders := watch.Leaders{}
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	for {
+		now := time.Now().In(env.Location)
+		results := api.FetchScoreboards(ctx, env.Provider, leagues, api.Day(now))
+		if ctx.Err() != nil {
+			// Interrupted mid-poll
+			return nil
+		}
+		for _, result := range results {
+			if result.Err != nil {
+				// Keep the last snapshot so nothing is missed once the
+				// league is reachable again. A stale copy from the cache
+				// is no newer than it.
+				fmt.Fprintf(env.Stderr, "%s %s/%s: %v\n", now.Format(env.ClockLayout), result.Sport, result.League, result.Err)
+				continue
+			}
+
+			if prev, ok := snapshots[result.LeagueRef]; ok {
+				for _, e := range watch.Diff(prev, result.Games, leaders) {
+					// Every team in a watched league counts as followed
+					followed := func(string) bool { return true }
+					if *desktop && notify.Wanted(env.Notifications, result.Sport, result.League, e, followed) {
+						if err := env.Notifier.Notify(notify.ForEvent(leagueName(env.Sports, result.LeagueRef), e)); err != nil {
+							fmt.Fprintf(env.Stderr, "%s %v\n", now.Format(env.ClockLayout), err)
+						}
+					}
+					if jsonOutput {
+						if err := enc.Encode(newWatchEvent(now, result.LeagueRef, e)); err != nil {
+							return err
+						}
+					} else {
+						fmt.Fprintf(env.Stdout, "%s  %s  %s\n", now.Format(env.ClockLayout), leagueName(env.Sports, result.LeagueRef), e)
+					}
+				}
+			}
+			snapshots[result.LeagueRef] = result.Games
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
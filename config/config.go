@@ -0,0 +1,386 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Favorite is a starred team. Teams are identified by ESPN team ID within
+// a sport and league; Name is kept for display without a lookup.
+type Favorite struct {
+	Sport  string `toml:"sport"`
+	League string `toml:"league"`
+	TeamID string `toml:"team_id"`
+	Name   string `toml:"name"`
+}
+
+// CustomLeague is an ESPN league added to the built-in list, identified by
+// the sport and league segments of its API path, e.g. "soccer" and
+// "fra.1" for site.api.espn.com/apis/site/v2/sports/soccer/fra.1.
+type CustomLeague struct {
+	Sport  string `toml:"sport"`
+	League string `toml:"league"`
+	Name   string `toml:"name,omitempty"`
+}
+
+// Desktop notification events, matching the watch package's event types.
+var NotifyEvents = []string{"game_started", "score_changed", "lead_changed", "period_changed", "game_final"}
+
+// DefaultNotifyEvents are the events sent for favorite teams without a
+// matching rule.
+var DefaultNotifyEvents = []string{"game_started", "lead_changed", "game_final"}
+
+// NotifyRule chooses the desktop notifications for a team, or for every
+// team in a league when TeamID is empty.
+type NotifyRule struct {
+	Sport  string   `toml:"sport"`
+	League string   `toml:"league"`
+	TeamID string   `toml:"team_id,omitempty"`
+	Events []string `toml:"events"`
+}
+
+// Notifications configures desktop notifications.
+type Notifications struct {
+	// Desktop turns desktop notifications on.
+	Desktop bool `toml:"desktop"`
+
+	// Events are sent for favorite teams no rule matches. Defaults to
+	// DefaultNotifyEvents.
+	Events []string `toml:"events,omitempty"`
+
+	// Rules override Events for particular teams or leagues. A rule with
+	// no events mutes its team or league.
+	Rules []NotifyRule `toml:"rules,omitempty"`
+}
+
+// EventsFor returns the desktop notification events wanted for a team.
+// Team rules win over league rules, which win over the defaults for
+// favorite teams; other teams get none.
+func (n Notifications) EventsFor(sport, league, teamID string, favorite bool) []string {
+	var leagueRule *NotifyRule
+	for i, rule := range n.Rules {
+		if rule.Sport != sport || rule.League != league {
+			continue
+		}
+		if rule.TeamID == teamID && teamID != "" {
+			return rule.Events
+		}
+		if rule.TeamID == "" && leagueRule == nil {
+			leagueRule = &n.Rules[i]
+		}
+	}
+	if leagueRule != nil {
+		return leagueRule.Events
+	}
+	if !favorite {
+		return nil
+	}
+	if n.Events != nil {
+		return n.Events
+	}
+	return DefaultNotifyEvents
+}
+
+// Alert is a rule that highlights games matching a condition, such as
+// "live && late && margin <= 5". An alert named after a built-in rule
+// replaces it, and one with an empty When turns the built-in rule off.
+type Alert struct {
+	Name string `toml:"name"`
+	When string `toml:"when"`
+
+	// Sport and League, when set, limit the alert to one sport or league.
+	Sport  string `toml:"sport,omitempty"`
+	League string `toml:"league,omitempty"`
+
+	// Notify also sends a desktop notification when a game starts
+	// matching. It needs notifications.desktop.
+	Notify bool `toml:"notify,omitempty"`
+}
+
+// Start views accepted by Config.StartView.
+const (
+	StartSports  = "sports"
+	StartMyTeams = "my-teams"
+	StartLive    = "live"
+	StartGames   = "games"
+)
+
+// Clock formats accepted by Config.Clock.
+const (
+	Clock12h = "12h"
+	Clock24h = "24h"
+)
+
+// Themes lists the color themes accepted by Config.Theme.
+var Themes = []string{"default", "light", "mono"}
+
+// DefaultRefreshInterval is how often live scores refresh when the config
+// doesn't say otherwise.
+const DefaultRefreshInterval = 30 * time.Second
+
+// Config is the user's persisted configuration. Every setting is optional;
+// the zero value behaves like the built-in defaults.
+type Config struct {
+	// RefreshInterval is a duration such as "30s" or "1m" between live
+	// score refreshes. "0" turns auto-refresh off.
+	RefreshInterval string `toml:"refresh_interval,omitempty"`
+
+	// StartView is the screen shown on launch: "sports", "my-teams",
+	// "live" or "games".
+	StartView string `toml:"start_view,omitempty"`
+
+	// DefaultLeague is the "sport/league" pair, e.g. "basketball/nba",
+	// opened by the "games" start view.
+	DefaultLeague string `toml:"default_league,omitempty"`
+
+	// Timezone is an IANA time zone name used for dates and kick-off
+	// times instead of the system zone.
+	Timezone string `toml:"timezone,omitempty"`
+
+	// Clock is "12h" or "24h".
+	Clock string `toml:"clock,omitempty"`
+
+	// Leagues restricts the league list to these "sport/league" pairs.
+	// An empty list enables every league.
+	Leagues []string `toml:"leagues,omitempty"`
+
+	// Theme is one of Themes.
+	Theme string `toml:"theme,omitempty"`
+
+	// CustomLeagues are added to the built-in leagues. They are always
+	// listed, even when Leagues is set.
+	CustomLeagues []CustomLeague `toml:"custom_leagues,omitempty"`
+
+	// Bell rings the terminal bell on score change notifications.
+	Bell bool `toml:"bell,omitempty"`
+
+	Notifications Notifications `toml:"notifications,omitempty"`
+
+	// Alerts add to and override the built-in alert rules.
+	Alerts []Alert `toml:"alerts,omitempty"`
+
+	// DiscoverLeagues probes a catalogue of further ESPN leagues on
+	// startup and lists those that respond.
+	DiscoverLeagues bool `toml:"discover_leagues,omitempty"`
+
+	Favorites []Favorite `toml:"favorites"`
+}
+
+// Path returns the location of the config file:
+// $XDG_CONFIG_HOME/sportsterminal/config.toml, falling back to the
+// platform's user config directory.
+func Path() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		var err error
+		dir, err = os.UserConfigDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to locate config directory: %w", err)
+		}
+	}
+	return filepath.Join(dir, "sportsterminal", "config.toml"), nil
+}
+
+// Load reads the config file at path. A missing file yields an empty
+// config rather than an error.
+func Load(path string) (Config, error) {
+	var cfg Config
+
+	if _, err := toml.DecodeFile(path, &cfg); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return Config{}, nil
+		}
+		return Config{}, fmt.Errorf("failed to read config %s: %w", path, err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// Validate checks that every setting holds a value the application
+// understands.
+func (c Config) Validate() error {
+	if c.RefreshInterval != "" {
+		d, err := time.ParseDuration(c.RefreshInterval)
+		if err != nil {
+			return fmt.Errorf("refresh_interval: %w", err)
+		}
+		if d < 0 {
+			return fmt.Errorf("refresh_interval must not be negative")
+		}
+	}
+
+	switch c.StartView {
+	case "", StartSports, StartMyTeams, StartLive:
+	case StartGames:
+		if c.DefaultLeague == "" {
+			return fmt.Errorf("start_view %q needs default_league", c.StartView)
+		}
+	default:
+		return fmt.Errorf("unknown start_view %q", c.StartView)
+	}
+
+	if c.DefaultLeague != "" {
+		if _, _, ok := SplitLeague(c.DefaultLeague); !ok {
+			return fmt.Errorf("default_league %q must look like sport/league", c.DefaultLeague)
+		}
+	}
+	for _, league := range c.Leagues {
+		if _, _, ok := SplitLeague(league); !ok {
+			return fmt.Errorf("league %q must look like sport/league", league)
+		}
+	}
+
+	for _, league := range c.CustomLeagues {
+		if league.Sport == "" || league.League == "" || strings.Contains(league.Sport+league.League, "/") {
+			return fmt.Errorf("custom league %q needs a sport and a league without slashes", league.Sport+"/"+league.League)
+		}
+	}
+
+	for _, event := range c.Notifications.Events {
+		if !contains(NotifyEvents, event) {
+			return fmt.Errorf("notifications: unknown event %q (want one of %s)", event, strings.Join(NotifyEvents, ", "))
+		}
+	}
+	for _, rule := range c.Notifications.Rules {
+		if rule.Sport == "" || rule.League == "" {
+			return fmt.Errorf("notifications: rules need a sport and a league")
+		}
+		for _, event := range rule.Events {
+			if !contains(NotifyEvents, event) {
+				return fmt.Errorf("notifications: unknown event %q (want one of %s)", event, strings.Join(NotifyEvents, ", "))
+			}
+		}
+	}
+
+	for _, alert := range c.Alerts {
+		if strings.TrimSpace(alert.Name) == "" {
+			return fmt.Errorf("alerts need a name")
+		}
+		if alert.League != "" && alert.Sport == "" {
+			return fmt.Errorf("alert %q: league needs a sport", alert.Name)
+		}
+	}
+
+	if c.Timezone != "" {
+		if _, err := time.LoadLocation(c.Timezone); err != nil {
+			return fmt.Errorf("timezone: %w", err)
+		}
+	}
+
+	switch c.Clock {
+	case "", Clock12h, Clock24h:
+	default:
+		return fmt.Errorf("clock must be %q or %q", Clock12h, Clock24h)
+	}
+
+	if c.Theme != "" && !contains(Themes, c.Theme) {
+		return fmt.Errorf("unknown theme %q (want one of %s)", c.Theme, strings.Join(Themes, ", "))
+	}
+
+	return nil
+}
+
+// Refresh returns the live score refresh interval. Zero means auto-refresh
+// is off.
+func (c Config) Refresh() time.Duration {
+	if c.RefreshInterval == "" {
+		return DefaultRefreshInterval
+	}
+	d, err := time.ParseDuration(c.RefreshInterval)
+	if err != nil || d < 0 {
+		return DefaultRefreshInterval
+	}
+	return d
+}
+
+// ClockLayout returns the time.Format layout for times of day.
+func (c Config) ClockLayout() string {
+	if c.Clock == Clock24h {
+		return "15:04"
+	}
+	return "3:04 PM"
+}
+
+// Location returns the configured time zone, or time.Local.
+func (c Config) Location() *time.Location {
+	if c.Timezone != "" {
+		if loc, err := time.LoadLocation(c.Timezone); err == nil {
+			return loc
+		}
+	}
+	return time.Local
+}
+
+// LeagueEnabled reports whether a league should be listed.
+func (c Config) LeagueEnabled(sport, league string) bool {
+	if len(c.Leagues) == 0 || contains(c.Leagues, sport+"/"+league) {
+		return true
+	}
+	for _, custom := range c.CustomLeagues {
+		if custom.Sport == sport && custom.League == league {
+			return true
+		}
+	}
+	return false
+}
+
+// SplitLeague splits a "sport/league" pair.
+func SplitLeague(s string) (sport, league string, ok bool) {
+	sport, league, ok = strings.Cut(s, "/")
+	if !ok || sport == "" || league == "" {
+		return "", "", false
+	}
+	return sport, league, true
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFavorite reports whether the team is starred.
+func (c Config) IsFavorite(sport, league, teamID string) bool {
+	return c.favoriteIndex(sport, league, teamID) >= 0
+}
+
+// ToggleFavorite stars the team if it isn't already and unstars it
+// otherwise. It returns the updated config and whether the team is now a
+// favorite. The receiver's Favorites slice is not modified.
+func (c Config) ToggleFavorite(fav Favorite) (Config, bool) {
+	i := c.favoriteIndex(fav.Sport, fav.League, fav.TeamID)
+
+	favorites := make([]Favorite, 0, len(c.Favorites)+1)
+	if i >= 0 {
+		favorites = append(favorites, c.Favorites[:i]...)
+		favorites = append(favorites, c.Favorites[i+1:]...)
+	} else {
+		favorites = append(favorites, c.Favorites...)
+		favorites = append(favorites, fav)
+	}
+
+	c.Favorites = favorites
+	return c, i < 0
+}
+
+func (c Config) favoriteIndex(sport, league, teamID string) int {
+	for i, fav := range c.Favorites {
+		if fav.Sport == sport && fav.League == league && fav.TeamID == teamID {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+)
+
+func TestEventsFor(t *testing.T) {
+	const settings = `
+desktop = true
+events = ["game_final"]
+
+[[rules]]
+sport = "basketball"
+league = "nba"
+events = ["game_started"]
+
+[[rules]]
+sport = "basketball"
+league = "nba"
+team_id = "2"
+events = ["score_changed"]
+
+[[rules]]
+sport = "basketball"
+league = "nba"
+team_id = "18"
+events = []
+
+[[rules]]
+sport = "hockey"
+league = "nhl"
+events = []
+`
+	var n Notifications
+	if _, err := toml.Decode(settings, &n); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name                  string
+		sport, league, teamID string
+		favorite              bool
+		want                  []string
+	}{
+		{"team rule", "basketball", "nba", "2", true, []string{"score_changed"}},
+		{"team rule, not a favorite", "basketball", "nba", "2", false, []string{"score_changed"}},
+		{"muted team", "basketball", "nba", "18", true, nil},
+		{"league rule", "basketball", "nba", "5", true, []string{"game_started"}},
+		{"league rule, not a favorite", "basketball", "nba", "5", false, []string{"game_started"}},
+		{"muted league", "hockey", "nhl", "1", true, nil},
+		{"favorite", "football", "nfl", "12", true, []string{"game_final"}},
+		{"not a favorite", "football", "nfl", "12", false, nil},
+		{"same team ID, other league", "football", "nfl", "2", false, nil},
+	}
+	for _, tt := range tests {
+		got := n.EventsFor(tt.sport, tt.league, tt.teamID, tt.favorite)
+		if len(got) != 0 || len(tt.want) != 0 {
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s: EventsFor = %q, want %q", tt.name, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestEventsForDefaults(t *testing.T) {
+	var n Notifications
+	if got := n.EventsFor("basketball", "nba", "2", true); !reflect.DeepEqual(got, DefaultNotifyEvents) {
+		t.Errorf("favorite without settings gets %q, want the defaults %q", got, DefaultNotifyEvents)
+	}
+
+	if _, err := toml.Decode("events = []", &n); err != nil {
+		t.Fatal(err)
+	}
+	if got := n.EventsFor("basketball", "nba", "2", true); len(got) != 0 {
+		t.Errorf("events = [] gives a favorite %q, want none", got)
+	}
+}
@@ -0,0 +1,158 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// favoritesHeader and favoritesKey match the two ways a config file lists
+// favorites: a [[favorites]] table per team, or a top-level array on one
+// line such as favorites = [].
+var (
+	favoritesHeader = regexp.MustCompile(`^\s*\[\[\s*favorites\s*\]\]\s*(#.*)?$`)
+	favoritesKey    = regexp.MustCompile(`^\s*favorites\s*=\s*\[.*\]\s*(#.*)?$`)
+	tableHeader     = regexp.MustCompile(`^\s*\[`)
+)
+
+// Save writes cfg to path, creating the parent directory if needed. The
+// file is replaced as a whole, so comments in it are lost; SaveFavorites
+// keeps them.
+func Save(path string, cfg Config) error {
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(cfg); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	return writeFile(path, buf.Bytes())
+}
+
+// SaveFavorites replaces the favorites in the config file at path,
+// creating it if needed. The rest of the file is left as it is on disk,
+// comments and edits made since it was loaded included. A file that no
+// longer parses is not touched.
+func SaveFavorites(path string, favorites []Favorite) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Save(path, Config{Favorites: favorites})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+
+	var want Config
+	if _, err := toml.Decode(string(data), &want); err != nil {
+		return fmt.Errorf("config %s has errors, not saving over it: %w", path, err)
+	}
+	want.Favorites = favorites
+	if len(favorites) == 0 {
+		want.Favorites = nil
+	}
+
+	edited, err := replaceFavorites(data, favorites)
+	if err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	var got Config
+	if _, err := toml.Decode(string(edited), &got); err != nil || !reflect.DeepEqual(got, want) {
+		// The file lists favorites in a way the edit doesn't follow, so
+		// write the settings out afresh
+		return Save(path, want)
+	}
+	return writeFile(path, edited)
+}
+
+// replaceFavorites returns the config file data with its favorites
+// replaced. The new [[favorites]] tables go where the first old one was,
+// or at the end. Comment and blank lines among the old tables are kept.
+func replaceFavorites(data []byte, favorites []Favorite) ([]byte, error) {
+	var tables bytes.Buffer
+	if len(favorites) > 0 {
+		enc := toml.NewEncoder(&tables)
+		enc.Indent = ""
+		if err := enc.Encode(struct {
+			Favorites []Favorite `toml:"favorites"`
+		}{favorites}); err != nil {
+			return nil, err
+		}
+	}
+
+	lines := strings.SplitAfter(string(data), "\n")
+	var out strings.Builder
+	inFavorites, topLevel, inserted := false, true, false
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case favoritesHeader.MatchString(line):
+			inFavorites, topLevel = true, false
+			if !inserted {
+				out.Write(tables.Bytes())
+				inserted = true
+			}
+			continue
+		case tableHeader.MatchString(line):
+			inFavorites, topLevel = false, false
+		case topLevel && favoritesKey.MatchString(line):
+			continue
+		case inFavorites && trimmed != "" && !strings.HasPrefix(trimmed, "#"):
+			continue
+		}
+		out.WriteString(line)
+	}
+
+	if !inserted && tables.Len() > 0 {
+		text := out.String()
+		if text != "" && !strings.HasSuffix(text, "\n") {
+			out.WriteString("\n")
+		}
+		if text != "" && !strings.HasSuffix(text, "\n\n") {
+			out.WriteString("\n")
+		}
+		out.Write(tables.Bytes())
+	}
+	return []byte(out.String()), nil
+}
+
+// writeFile replaces the file at path with data atomically, so a crash or
+// a concurrent reader never sees half a config. A symlinked config is
+// written through to its target.
+func writeFile(path string, data []byte) error {
+	if target, err := filepath.EvalSymlinks(path); err == nil {
+		path = target
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	mode := os.FileMode(0o644)
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	tmp, err := os.CreateTemp(dir, ".config-*")
+	if err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Chmod(mode)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const commentedConfig = `refresh_interval = "30s"          # live score refresh
+clock = "24h"
+
+# Any ESPN league
+[[custom_leagues]]
+sport = "soccer"
+league = "fra.1"
+name = "Ligue 1"
+
+[[favorites]]
+sport = "basketball"
+league = "nba"
+team_id = "2"
+name = "Boston Celtics"
+
+# Alerts of my own
+[[alerts]]
+name = "blowout"
+when = "margin >= 30"
+`
+
+var celtics = Favorite{Sport: "basketball", League: "nba", TeamID: "2", Name: "Boston Celtics"}
+var knicks = Favorite{Sport: "basketball", League: "nba", TeamID: "18", Name: "New York Knicks"}
+
+func writeConfig(t *testing.T, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestSaveFavoritesKeepsComments(t *testing.T) {
+	path := writeConfig(t, commentedConfig)
+	before, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, favorites := range [][]Favorite{{celtics, knicks}, nil, {knicks}} {
+		if err := SaveFavorites(path, favorites); err != nil {
+			t.Fatal(err)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, comment := range []string{"# live score refresh", "# Any ESPN league", "# Alerts of my own"} {
+			if !strings.Contains(string(data), comment) {
+				t.Errorf("saving %d favorites lost %q:\n%s", len(favorites), comment, data)
+			}
+		}
+
+		after, err := Load(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := before
+		want.Favorites = favorites
+		if !reflect.DeepEqual(after, want) {
+			t.Errorf("saving %d favorites loaded back as\n%+v\nwant\n%+v", len(favorites), after, want)
+		}
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("mode = %v, want 0600 kept", info.Mode().Perm())
+	}
+}
+
+func TestSaveFavoritesLayouts(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"no favorites", "clock = \"24h\"\n"},
+		{"no trailing newline", "clock = \"24h\""},
+		{"empty array", "clock = \"24h\"\nfavorites = []\n"},
+		{"inline array", "clock = \"24h\"\nfavorites = [\n  {sport = \"basketball\", league = \"nba\", team_id = \"2\", name = \"Boston Celtics\"},\n]\n"},
+		{"table last", "clock = \"24h\"\n\n[notifications]\ndesktop = true\n"},
+		{"empty file", ""},
+	}
+	for _, tt := range tests {
+		path := writeConfig(t, tt.data)
+		if err := SaveFavorites(path, []Favorite{knicks}); err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		cfg, err := Load(path)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(cfg.Favorites, []Favorite{knicks}) {
+			t.Errorf("%s: favorites = %+v, want the Knicks", tt.name, cfg.Favorites)
+		}
+		if tt.data != "" && cfg.Clock != "24h" {
+			t.Errorf("%s: clock = %q, want it kept", tt.name, cfg.Clock)
+		}
+	}
+}
+
+func TestSaveFavoritesNewFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sportsterminal", "config.toml")
+	if err := SaveFavorites(path, []Favorite{celtics}); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(cfg.Favorites, []Favorite{celtics}) {
+		t.Errorf("favorites = %+v, want the Celtics", cfg.Favorites)
+	}
+}
+
+func TestSaveFavoritesLeavesBrokenFile(t *testing.T) {
+	const broken = "clock = \"24h\n[[favorites]]\n"
+	path := writeConfig(t, broken)
+	if err := SaveFavorites(path, []Favorite{celtics}); err == nil {
+		t.Error("saved over a config that doesn't parse")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != broken {
+		t.Errorf("config changed to\n%s", data)
+	}
+}
@@ -1,24 +1,157 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
 	"os"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elliota43/sportsterminal/alerts"
+	"github.com/elliota43/sportsterminal/api"
+	"github.com/elliota43/sportsterminal/cli"
+	"github.com/elliota43/sportsterminal/config"
+	"github.com/elliota43/sportsterminal/notify"
 	"github.com/elliota43/sportsterminal/ui"
 )
 
 var version = "1.0.0"
 
+const usage = `Usage: sportsterminal [flags] [command]
+
+Without a command, starts the interactive terminal UI.
+
+Commands:
+  scores <league>   print a league's scores (--date, --days, --live, --format table|json|csv)
+  game <league> <eventID>
+                    print a game summary (--format text|json)
+  watch <league>... print score changes as they happen (--all, --interval, --notify, --format text|json)
+
+Flags:
+`
+
 func main() {
+	client, err := api.NewClientFromEnv()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
+	var showVersion bool
+	flag.BoolVar(&showVersion, "version", false, "print version and exit")
+	flag.BoolVar(&showVersion, "v", false, "print version and exit (shorthand)")
+	flag.StringVar(&client.BaseURL, "api-base", client.BaseURL, "base URL of the ESPN API (env "+api.EnvBaseURL+")")
+	flag.StringVar(&client.UserAgent, "user-agent", client.UserAgent, "User-Agent sent with API requests (env "+api.EnvUserAgent+")")
+	flag.DurationVar(&client.Timeout, "timeout", client.Timeout, "timeout for each API request (env "+api.EnvTimeout+")")
+	flag.IntVar(&client.Retries, "retries", client.Retries, "times a request failing with a network error, 429 or 5xx is retried (env "+api.EnvRetries+")")
+
+	// An unknown cache directory only costs the on-disk cache
+	cacheDir, _ := api.DefaultCacheDir()
+	var noCache bool
+	flag.StringVar(&cacheDir, "cache-dir", cacheDir, "directory for cached API responses; empty caches in memory only")
+	flag.BoolVar(&noCache, "no-cache", false, "send every API request instead of reusing cached responses")
+	var offline bool
+	flag.BoolVar(&offline, "offline", false, "show only responses saved in the cache, without network access")
+	var recordDir, replayDir string
+	var replaySpeed float64
+	flag.StringVar(&recordDir, "record", "", "save scoreboard and game responses to `dir` for --replay")
+	flag.StringVar(&replayDir, "replay", "", "serve scoreboards and games recorded with --record in `dir` instead of ESPN")
+	flag.Float64Var(&replaySpeed, "replay-speed", 1, "rate a --replay recording plays back at; 0 shows the last of everything")
+
+	configPath, err := config.Path()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	flag.StringVar(&configPath, "config", configPath, "path to the config file")
+
+	window := api.DefaultWindow
+	flag.IntVar(&window.Lookahead, "lookahead", window.Lookahead, "days after today included in the upcoming games view")
+	flag.IntVar(&window.Lookbehind, "lookbehind", window.Lookbehind, "days before today included in the upcoming games view")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "%s", usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
 	// Handle version flag
-	if len(os.Args) > 1 && (os.Args[1] == "--version" || os.Args[1] == "-v") {
+	if showVersion {
 		fmt.Printf("sportsterminal version %s\n", version)
 		return
 	}
 
+	if offline && (noCache || cacheDir == "") {
+		fmt.Fprintln(os.Stderr, "Error: --offline needs the on-disk cache")
+		os.Exit(2)
+	}
+	if recordDir != "" && (replayDir != "" || offline) {
+		fmt.Fprintln(os.Stderr, "Error: --record can't be combined with --replay or --offline")
+		os.Exit(2)
+	}
+	if replaySpeed < 0 {
+		fmt.Fprintln(os.Stderr, "Error: --replay-speed can't be negative")
+		os.Exit(2)
+	}
+	if recordDir != "" {
+		// Record every response as it is fetched, rather than the
+		// responses the cache lets through
+		if err := os.MkdirAll(recordDir, 0o755); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+		client.HTTPClient.Transport = api.NewRecorder(recordDir, client.HTTPClient.Transport)
+		noCache = true
+	}
+	if !noCache {
+		client.Cache = api.NewCache(cacheDir)
+		client.Cache.Offline = offline
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
+	alertRules, err := alerts.New(cfg.Alerts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid config %s: %v\n", configPath, err)
+		os.Exit(2)
+	}
+
+	// Prune only once the config is loaded, so no goroutine runs while
+	// startup state is still being set. Offline, old entries are all
+	// there is to show. Pruning is housekeeping, so it neither delays
+	// startup nor fails it.
+	if client.Cache != nil && !offline {
+		go func() { _ = client.Cache.Prune() }()
+	}
+
+	var provider api.Provider = api.NewESPNProvider(client)
+	if replayDir != "" {
+		replay, err := api.NewReplayProvider(replayDir, replaySpeed, cfg.Location())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+		provider = replay
+	}
+
+	// Subcommands print to stdout instead of starting the TUI
+	if flag.NArg() > 0 {
+		os.Exit(runCommand(provider, cfg, flag.Args()))
+	}
+
 	p := tea.NewProgram(
-		ui.NewModel(),
+		ui.NewModel(provider, ui.Options{
+			Window:     window,
+			Config:     cfg,
+			ConfigPath: configPath,
+			Notifier:   notify.Detect(),
+			Alerts:     alertRules,
+			Offline:    offline || replayDir != "",
+			Location:   cfg.Location(),
+		}),
 		tea.WithAltScreen(),
 		tea.WithMouseCellMotion(),
 	)
@@ -29,3 +162,41 @@ func main() {
 	}
 }
 
+// runCommand runs a subcommand and returns the process exit status.
+func runCommand(provider api.Provider, cfg config.Config, args []string) int {
+	command, ok := cli.Commands[args[0]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Error: unknown command %q\n", args[0])
+		return 2
+	}
+
+	sports := provider.Leagues()
+	for _, league := range cfg.CustomLeagues {
+		sports = api.MergeLeagues(sports, []api.Sport{api.CustomSport(league.Sport, league.League, league.Name)})
+	}
+
+	refresh := cfg.Refresh()
+	if refresh <= 0 {
+		refresh = config.DefaultRefreshInterval
+	}
+
+	env := cli.Env{
+		Provider:        provider,
+		Sports:          sports,
+		ClockLayout:     cfg.ClockLayout(),
+		Location:        cfg.Location(),
+		RefreshInterval: refresh,
+		Notifications:   cfg.Notifications,
+		Notifier:        notify.Detect(),
+		Stdout:          os.Stdout,
+		Stderr:          os.Stderr,
+	}
+	if err := command(env, args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		if errors.Is(err, cli.ErrUsage) {
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
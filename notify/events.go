@@ -0,0 +1,43 @@
+package notify
+
+import (
+	"fmt"
+
+	"github.com/elliota43/sportsterminal/config"
+	"github.com/elliota43/sportsterminal/watch"
+)
+
+// titles name each event type in a notification title.
+var titles = map[watch.EventType]string{
+	watch.GameStarted:   "Game started",
+	watch.ScoreChanged:  "Score",
+	watch.LeadChanged:   "Lead change",
+	watch.PeriodChanged: "New period",
+	watch.GameFinal:     "Final",
+}
+
+// ForEvent builds the notification for a score change event.
+func ForEvent(league string, e watch.Event) Notification {
+	title := titles[e.Type]
+	if title == "" {
+		title = string(e.Type)
+	}
+	return Notification{
+		Title: fmt.Sprintf("%s • %s", league, title),
+		Body:  e.String(),
+	}
+}
+
+// Wanted reports whether the notification settings ask for an event,
+// going by the rules for either team in the game. favorite reports
+// whether a team in the league is starred.
+func Wanted(settings config.Notifications, sport, league string, e watch.Event, favorite func(teamID string) bool) bool {
+	for _, teamID := range []string{e.Game.AwayTeam.ID, e.Game.HomeTeam.ID} {
+		for _, event := range settings.EventsFor(sport, league, teamID, favorite(teamID)) {
+			if event == string(e.Type) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package notify
+
+import (
+	"testing"
+
+	"github.com/elliota43/sportsterminal/api"
+	"github.com/elliota43/sportsterminal/config"
+	"github.com/elliota43/sportsterminal/watch"
+)
+
+func TestWanted(t *testing.T) {
+	settings := config.Notifications{
+		Desktop: true,
+		Rules: []config.NotifyRule{
+			{Sport: "basketball", League: "nba", TeamID: "2", Events: []string{"score_changed"}},
+			{Sport: "basketball", League: "nba", TeamID: "18", Events: []string{}},
+			{Sport: "basketball", League: "nba", Events: []string{"period_changed"}},
+		},
+	}
+	starred := map[string]bool{"18": true, "20": true}
+	favorite := func(teamID string) bool { return starred[teamID] }
+
+	game := func(away, home string) api.Game {
+		return api.Game{AwayTeam: api.Team{ID: away}, HomeTeam: api.Team{ID: home}}
+	}
+	tests := []struct {
+		name   string
+		sport  string
+		league string
+		event  watch.EventType
+		game   api.Game
+		want   bool
+	}{
+		{"team rule for the home team", "basketball", "nba", watch.ScoreChanged, game("5", "2"), true},
+		{"team rule beats the league rule", "basketball", "nba", watch.PeriodChanged, game("2", "2"), false},
+		{"league rule for the other team", "basketball", "nba", watch.PeriodChanged, game("2", "5"), true},
+		{"league rule beats favorite defaults", "basketball", "nba", watch.GameFinal, game("20", "6"), false},
+		{"muted favorite", "basketball", "nba", watch.GameFinal, game("18", "18"), false},
+		{"favorite defaults", "hockey", "nhl", watch.GameFinal, game("20", "1"), true},
+		{"event outside the defaults", "hockey", "nhl", watch.ScoreChanged, game("20", "1"), false},
+		{"no favorite", "hockey", "nhl", watch.GameFinal, game("3", "4"), false},
+	}
+	for _, tt := range tests {
+		e := watch.Event{Type: tt.event, Game: tt.game}
+		if got := Wanted(settings, tt.sport, tt.league, e, favorite); got != tt.want {
+			t.Errorf("%s: Wanted = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
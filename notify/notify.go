@@ -0,0 +1,129 @@
+// Package notify sends desktop notifications through notify-send or the
+// freedesktop notifications D-Bus interface.
+package notify
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AppName identifies the application to the notification daemon.
+const AppName = "sportsterminal"
+
+// Notification is a desktop notification.
+type Notification struct {
+	Title string
+	Body  string
+}
+
+// Notifier delivers desktop notifications.
+type Notifier interface {
+	Notify(n Notification) error
+}
+
+// NotifySend delivers notifications by running notify-send.
+type NotifySend struct {
+	// Path is the notify-send executable.
+	Path string
+
+	// Timeout is how long the notification stays on screen. Zero leaves
+	// it to the notification daemon.
+	Timeout time.Duration
+}
+
+func (s NotifySend) Notify(n Notification) error {
+	args := []string{"--app-name", AppName}
+	if s.Timeout > 0 {
+		args = append(args, "--expire-time", fmt.Sprint(s.Timeout.Milliseconds()))
+	}
+	args = append(args, "--", n.Title, n.Body)
+
+	if out, err := exec.Command(s.Path, args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("notify-send failed: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// DBus delivers notifications by calling the
+// org.freedesktop.Notifications.Notify method on the session bus through
+// gdbus.
+type DBus struct {
+	// Path is the gdbus executable.
+	Path string
+
+	// Timeout is how long the notification stays on screen. Zero leaves
+	// it to the notification daemon.
+	Timeout time.Duration
+}
+
+func (d DBus) Notify(n Notification) error {
+	timeout := int64(-1)
+	if d.Timeout > 0 {
+		timeout = d.Timeout.Milliseconds()
+	}
+
+	// Notify(app_name, replaces_id, app_icon, summary, body, actions,
+	// hints, expire_timeout)
+	args := []string{
+		"call", "--session",
+		"--dest", "org.freedesktop.Notifications",
+		"--object-path", "/org/freedesktop/Notifications",
+		"--method", "org.freedesktop.Notifications.Notify",
+		gvariantString(AppName), "0", gvariantString(""),
+		gvariantString(n.Title), gvariantString(n.Body),
+		"[]", "{}", fmt.Sprint(timeout),
+	}
+
+	if out, err := exec.Command(d.Path, args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("D-Bus notification failed: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// gvariantString quotes s as a GVariant text-format string.
+func gvariantString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `'`, `\'`)
+	return "'" + s + "'"
+}
+
+// Detect returns a notifier for this system: notify-send when installed,
+// otherwise gdbus. It returns nil when neither is available.
+func Detect() Notifier {
+	if path, err := exec.LookPath("notify-send"); err == nil {
+		return NotifySend{Path: path}
+	}
+	if path, err := exec.LookPath("gdbus"); err == nil {
+		return DBus{Path: path}
+	}
+	return nil
+}
+
+// Recorder is a Notifier that keeps notifications instead of showing
+// them, for tests and dry runs.
+type Recorder struct {
+	mu   sync.Mutex
+	sent []Notification
+	// Err, when set, is returned by every Notify call.
+	Err error
+}
+
+func (r *Recorder) Notify(n Notification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.Err != nil {
+		return r.Err
+	}
+	r.sent = append(r.sent, n)
+	return nil
+}
+
+// Sent returns the notifications delivered so far.
+func (r *Recorder) Sent() []Notification {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Notification(nil), r.sent...)
+}
@@ -0,0 +1,22 @@
+package notify
+
+import "testing"
+
+func TestGvariantString(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"", `''`},
+		{"Celtics 102 - 99 Knicks", `'Celtics 102 - 99 Knicks'`},
+		{"Hawai'i", `'Hawai\'i'`},
+		{`C:\path`, `'C:\\path'`},
+		{`\'`, `'\\\''`},
+		{`"quoted"`, `'"quoted"'`},
+	}
+	for _, tt := range tests {
+		if got := gvariantString(tt.input); got != tt.want {
+			t.Errorf("gvariantString(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+}
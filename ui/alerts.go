@@ -0,0 +1,152 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elliota43/sportsterminal/alerts"
+	"github.com/elliota43/sportsterminal/api"
+	"github.com/elliota43/sportsterminal/notify"
+	"github.com/elliota43/sportsterminal/watch"
+)
+
+// alertKey identifies a rule firing for a game, so each rule notifies
+// once per game however often the game refreshes.
+func alertKey(rule, gameID string) string {
+	return rule + "/" + gameID
+}
+
+// gameAlerts returns the rules a game matches.
+func (m Model) gameAlerts(ref api.LeagueRef, game api.Game) []alerts.Rule {
+	return m.alerts.Match(alerts.FromGame(ref.Sport, ref.League, game))
+}
+
+// selectedRef returns the league open in the games and detail views.
+func (m Model) selectedRef() (api.LeagueRef, bool) {
+	if m.selectedSport == nil || m.selectedLeague == nil {
+		return api.LeagueRef{}, false
+	}
+	return api.LeagueRef{Sport: m.selectedSport.ID, League: m.selectedLeague.ID}, true
+}
+
+// checkAlerts evaluates the alert rules against refreshed games and
+// notifies about each game that starts matching a rule.
+func (m Model) checkAlerts(ref api.LeagueRef, games []api.Game) (Model, tea.Cmd) {
+	if m.alerts == nil {
+		return m, nil
+	}
+
+	var events []notification
+	var cmds []tea.Cmd
+	for _, game := range games {
+		var fired []notification
+		var sent []tea.Cmd
+		m, fired, sent = m.fireAlerts(ref, game, m.gameAlerts(ref, game))
+		events = append(events, fired...)
+		cmds = append(cmds, sent...)
+	}
+
+	m, cmd := m.notify(events)
+	return m, tea.Batch(append(cmds, cmd)...)
+}
+
+// checkDetailAlerts notifies about the game in the detail view starting
+// to match a rule, once per rule and game as on the scoreboard.
+func (m Model) checkDetailAlerts(detail *api.GameDetail) (Model, tea.Cmd) {
+	ref, ok := m.selectedRef()
+	if m.alerts == nil || !ok || detail == nil {
+		return m, nil
+	}
+
+	m, events, cmds := m.fireAlerts(ref, detailGame(detail), m.detailAlerts(detail))
+	m, cmd := m.notify(events)
+	return m, tea.Batch(append(cmds, cmd)...)
+}
+
+// fireAlerts returns the notifications for the rules a game matches that
+// haven't fired for it yet, and marks them fired. Rules that notify also
+// get a desktop notification.
+func (m Model) fireAlerts(ref api.LeagueRef, game api.Game, rules []alerts.Rule) (Model, []notification, []tea.Cmd) {
+	var events []notification
+	var cmds []tea.Cmd
+	league := m.leagueName(ref.Sport, ref.League)
+	for _, rule := range rules {
+		key := alertKey(rule.Name, game.ID)
+		if m.alerted[key] {
+			continue
+		}
+		if m.alerted == nil {
+			m.alerted = map[string]bool{}
+		}
+		m.alerted[key] = true
+
+		n := notification{at: time.Now(), league: league, alert: rule.Name, event: watch.Event{Game: game}}
+		events = append(events, n)
+		if rule.Notify && m.notifier != nil {
+			cmds = append(cmds, desktopNotifyCmd(m.notifier, notify.Notification{
+				Title: fmt.Sprintf("%s • %s", league, rule.Name),
+				Body:  n.text(),
+			}))
+		}
+	}
+	return m, events, cmds
+}
+
+// detailGame returns the scoreboard view of a game summary, as far as
+// notifications describe it.
+func detailGame(detail *api.GameDetail) api.Game {
+	team := func(t api.TeamDetail) api.Team {
+		return api.Team{ID: t.ID, Name: t.Name, ShortName: t.ShortName, Abbreviation: t.Abbreviation, Score: t.Score}
+	}
+	return api.Game{
+		ID:           detail.ID,
+		Name:         detail.Name,
+		Status:       detail.Status,
+		StatusDetail: detail.StatusDetail,
+		State:        detail.State,
+		IsLive:       detail.IsLive,
+		HomeTeam:     team(detail.HomeTeam),
+		AwayTeam:     team(detail.AwayTeam),
+	}
+}
+
+// checkResultAlerts runs checkAlerts over several leagues' scoreboards.
+func (m Model) checkResultAlerts(results []api.LeagueGames) (Model, tea.Cmd) {
+	var cmds []tea.Cmd
+	for _, result := range results {
+		if result.Failed() {
+			continue
+		}
+		var cmd tea.Cmd
+		m, cmd = m.checkAlerts(result.LeagueRef, result.Games)
+		cmds = append(cmds, cmd)
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// alertBadge renders the names of the rules a game matches, or "".
+func alertBadge(rules []alerts.Rule) string {
+	if len(rules) == 0 {
+		return ""
+	}
+	return selectedItemStyle.UnsetPadding().Render("🚨 " + strings.Join(alerts.Names(rules), ", "))
+}
+
+// detailAlerts returns the rules the game in the detail view matches.
+func (m Model) detailAlerts(detail *api.GameDetail) []alerts.Rule {
+	ref, ok := m.selectedRef()
+	if !ok || detail == nil {
+		return nil
+	}
+	return m.alerts.Match(alerts.FromDetail(ref.Sport, ref.League, detail))
+}
+
+// openCloseGames switches to the Close Games view: the All Live Games
+// dashboard narrowed to games matching an alert rule.
+func (m Model) openCloseGames() (Model, tea.Cmd) {
+	m, cmd := m.openLive()
+	m.closeGames = true
+	return m, cmd
+}
@@ -0,0 +1,115 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/elliota43/sportsterminal/api"
+)
+
+// boxScoreNameWidth caps the player name column of box score tables.
+const boxScoreNameWidth = 22
+
+// renderBoxScore renders a team's player box score as one column-aligned
+// table per group. selected is the index of the highlighted player counted
+// across all of the team's groups, or out of range for none. It returns the
+// lines and, for every player in order, the line their row is on.
+func (m Model) renderBoxScore(team api.TeamDetail, selected int) (lines []string, playerRows []int) {
+	sectionStyle := lipgloss.NewStyle().Bold(true).Foreground(accentColor)
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(primaryColor)
+	rowStyle := lipgloss.NewStyle().Foreground(textColor)
+
+	lines = append(lines, sectionStyle.Render(fmt.Sprintf("📋 Box Score - %s", team.Name)))
+	lines = append(lines, "")
+
+	player := 0
+	for _, group := range team.BoxScore {
+		widths := boxScoreColumnWidths(group)
+
+		if group.Name != "" {
+			lines = append(lines, teamStyle.Render("  "+group.Name))
+		}
+
+		header := fmt.Sprintf("  %-*s", boxScoreNameWidth+5, "Player")
+		for i, label := range group.Labels {
+			header += fmt.Sprintf(" %*s", widths[i], label)
+		}
+		lines = append(lines, headerStyle.Render(header))
+
+		for _, p := range group.Players {
+			name := p.ShortName
+			if name == "" {
+				name = p.Name
+			}
+			row := fmt.Sprintf("  %-*s %-4s", boxScoreNameWidth, truncate(name, boxScoreNameWidth), p.Position)
+
+			if p.DidNotPlay || len(p.Stats) == 0 {
+				reason := "DNP"
+				if p.Reason != "" {
+					reason += " - " + p.Reason
+				}
+				row += " " + reason
+			} else {
+				for i, width := range widths {
+					value := ""
+					if i < len(p.Stats) {
+						value = p.Stats[i]
+					}
+					row += fmt.Sprintf(" %*s", width, value)
+				}
+			}
+
+			style := rowStyle
+			switch {
+			case player == selected:
+				style = selectedItemStyle.UnsetPadding()
+			case p.DidNotPlay:
+				style = statusStyle
+			}
+
+			playerRows = append(playerRows, len(lines))
+			lines = append(lines, style.Render(row))
+			player++
+		}
+
+		if len(group.Totals) > 0 {
+			totals := fmt.Sprintf("  %-*s", boxScoreNameWidth+5, "Totals")
+			for i, width := range widths {
+				value := ""
+				if i < len(group.Totals) {
+					value = group.Totals[i]
+				}
+				totals += fmt.Sprintf(" %*s", width, value)
+			}
+			lines = append(lines, statusStyle.Render("  "+strings.Repeat("-", len(totals)-2)))
+			lines = append(lines, teamStyle.Render(totals))
+		}
+		lines = append(lines, "")
+	}
+
+	return lines, playerRows
+}
+
+// boxScoreColumnWidths sizes each stat column to fit its label and every
+// value in it, so rows line up regardless of sport.
+func boxScoreColumnWidths(group api.BoxScoreGroup) []int {
+	widths := make([]int, len(group.Labels))
+	for i, label := range group.Labels {
+		widths[i] = len(label)
+	}
+
+	grow := func(values []string) {
+		for i, v := range values {
+			if i < len(widths) && len(v) > widths[i] {
+				widths[i] = len(v)
+			}
+		}
+	}
+	for _, p := range group.Players {
+		grow(p.Stats)
+	}
+	grow(group.Totals)
+
+	return widths
+}
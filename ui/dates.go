@@ -0,0 +1,68 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/elliota43/sportsterminal/api"
+)
+
+// today returns the start of the current day in loc.
+func today(loc *time.Location) time.Time {
+	now := time.Now().In(loc)
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+}
+
+// updateDatePicker handles keys while the date picker is open.
+func (m Model) updateDatePicker(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+
+	case tea.KeyEsc:
+		m.datePicker = false
+		m.dateInput = ""
+		m.dateErr = nil
+		return m, nil
+
+	case tea.KeyEnter:
+		date, err := api.ParseDay(m.dateInput, m.selectedDate)
+		if err != nil {
+			m.dateErr = err
+			return m, nil
+		}
+		m.datePicker = false
+		m.dateInput = ""
+		m.dateErr = nil
+		m.selectedDate = date
+		return m.reloadGames()
+
+	case tea.KeyBackspace:
+		if len(m.dateInput) > 0 {
+			runes := []rune(m.dateInput)
+			m.dateInput = string(runes[:len(runes)-1])
+		}
+		return m, nil
+
+	case tea.KeyRunes, tea.KeySpace:
+		m.dateInput += string(msg.Runes)
+		return m, nil
+	}
+
+	return m, nil
+}
+
+func (m Model) renderDatePicker() string {
+	prompt := selectedItemStyle.Render(fmt.Sprintf("Go to date: %s█", m.dateInput))
+	hint := statusStyle.Render("  e.g. 2026-10-16, 10/16, Oct 16, tomorrow, +3, -1")
+
+	lines := []string{prompt, hint}
+	if m.dateErr != nil {
+		lines = append(lines, "", errorStyle.Render(fmt.Sprintf("Error: %v", m.dateErr)))
+	}
+	lines = append(lines, m.renderHelp("enter go • esc cancel"))
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
@@ -0,0 +1,105 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elliota43/sportsterminal/api"
+)
+
+const (
+	// firstRetry is the wait before the UI first retries a failed load
+	// by itself; it doubles with each failure up to maxRetry.
+	firstRetry = 5 * time.Second
+	maxRetry   = 2 * time.Minute
+)
+
+// retryMsg retries a failed load in the view it failed in.
+type retryMsg struct {
+	at    time.Time
+	state viewState
+}
+
+// errorMessage explains a failed load in words, for API errors, or
+// returns the error text otherwise.
+func errorMessage(err error) string {
+	if errors.Is(err, api.ErrNotRecorded) {
+		return "This isn't in the recording being replayed."
+	}
+
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) {
+		return fmt.Sprintf("Error: %v", err)
+	}
+
+	switch apiErr.Kind {
+	case api.KindNetwork:
+		return "Can't reach ESPN. Check your internet connection."
+	case api.KindTimeout:
+		return "ESPN took too long to answer."
+	case api.KindRateLimited:
+		return "ESPN is limiting requests for now."
+	case api.KindServer:
+		return fmt.Sprintf("ESPN is having trouble (HTTP %d).", apiErr.StatusCode)
+	case api.KindClient:
+		if apiErr.StatusCode == http.StatusNotFound {
+			return "ESPN has no data for this."
+		}
+		return fmt.Sprintf("ESPN turned the request down (HTTP %d).", apiErr.StatusCode)
+	case api.KindParse:
+		return "ESPN sent data sportsterminal couldn't read."
+	}
+	return fmt.Sprintf("Error: %v", err)
+}
+
+// renderError renders the current error and when it will be retried.
+func (m Model) renderError() string {
+	text := errorMessage(m.err)
+	if !m.retryAt.IsZero() {
+		layout := strings.Replace(clockLayout, "04", "04:05", 1)
+		text += fmt.Sprintf(" Retrying at %s, or press r.", m.retryAt.In(m.loc).Format(layout))
+	}
+	return errorStyle.Render(text)
+}
+
+// scheduleRetry arranges for a load that failed with a temporary error to
+// be retried, as soon as the server allows or else backing off with each
+// failure in a row. A successful load resets the backoff.
+func (m Model) scheduleRetry() (Model, tea.Cmd) {
+	retryable, wait := api.Retryable(m.err)
+	if m.err == nil {
+		m.retries = 0
+	}
+	if !retryable {
+		m.retryAt = time.Time{}
+		return m, nil
+	}
+
+	if wait == 0 {
+		wait = firstRetry << m.retries
+		if wait > maxRetry || wait <= 0 {
+			wait = maxRetry
+		}
+	}
+	m.retries++
+	m.retryAt = time.Now().Add(wait)
+
+	msg := retryMsg{at: m.retryAt, state: m.state}
+	return m, tea.Tick(wait, func(time.Time) tea.Msg { return msg })
+}
+
+// retry reloads the view when a scheduled retry is still due. The
+// backoff carries over to the reload, which a refresh would reset.
+func (m Model) retry(msg retryMsg) (Model, tea.Cmd) {
+	if !msg.at.Equal(m.retryAt) || msg.state != m.state || m.err == nil {
+		return m, nil
+	}
+	retries := m.retries
+	m, cmd := m.refresh()
+	m.retries = retries
+	return m, cmd
+}
@@ -0,0 +1,335 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/elliota43/sportsterminal/api"
+	"github.com/elliota43/sportsterminal/config"
+)
+
+// myTeamsLookahead is the minimum number of days searched for a favorite
+// team's next game.
+const myTeamsLookahead = 7
+
+type myTeamsLoadedMsg struct {
+	id      uint64
+	results []api.LeagueGames
+}
+
+type configSavedMsg struct {
+	err error
+}
+
+func loadMyTeamsCmd(provider api.Provider, req request, favorites []config.Favorite, dates api.DateRange) tea.Cmd {
+	return func() tea.Msg {
+		return myTeamsLoadedMsg{id: req.id, results: api.FetchScoreboards(req.ctx, provider, favoriteLeagues(favorites), dates)}
+	}
+}
+
+func saveFavoritesCmd(path string, favorites []config.Favorite) tea.Cmd {
+	return func() tea.Msg {
+		return configSavedMsg{err: config.SaveFavorites(path, favorites)}
+	}
+}
+
+// saveFavorites saves the favorites, or, while a save is running, has
+// them saved once it finishes. Saves never overlap, so an older list can't
+// land after a newer one.
+func (m Model) saveFavorites() (Model, tea.Cmd) {
+	if m.savingConfig {
+		m.saveQueued = true
+		return m, nil
+	}
+	m.savingConfig = true
+	return m, saveFavoritesCmd(m.configPath, m.config.Favorites)
+}
+
+// configSaved handles a finished save, starting the one queued behind it.
+// Its error is only reported when no newer save follows.
+func (m Model) configSaved(msg configSavedMsg) (Model, tea.Cmd) {
+	m.savingConfig = false
+	if m.saveQueued {
+		m.saveQueued = false
+		return m.saveFavorites()
+	}
+	if msg.err != nil {
+		m.notice = fmt.Sprintf("Couldn't save favorites: %v", msg.err)
+	}
+	return m, nil
+}
+
+// favoriteLeagues returns each league with at least one favorite team,
+// once, in the order first starred.
+func favoriteLeagues(favorites []config.Favorite) []api.LeagueRef {
+	seen := map[api.LeagueRef]bool{}
+	var leagues []api.LeagueRef
+	for _, fav := range favorites {
+		ref := api.LeagueRef{Sport: fav.Sport, League: fav.League}
+		if !seen[ref] {
+			seen[ref] = true
+			leagues = append(leagues, ref)
+		}
+	}
+	return leagues
+}
+
+// myTeamsRange returns the days searched for favorites' games.
+func (m Model) myTeamsRange() api.DateRange {
+	lookahead := m.window.Lookahead
+	if lookahead < myTeamsLookahead {
+		lookahead = myTeamsLookahead
+	}
+	return api.Days(today(m.loc), today(m.loc).AddDate(0, 0, lookahead))
+}
+
+// openMyTeams switches to the My Teams dashboard.
+func (m Model) openMyTeams() (Model, tea.Cmd) {
+	m, req := m.beginRequest()
+	m.state = myTeamsView
+	m.myTeamsCursor = 0
+	m.myTeamsScrollOffset = 0
+	m.err = nil
+	if len(m.config.Favorites) == 0 {
+		m.myTeamsResults = nil
+		return m, nil
+	}
+	m.loadingMyTeams = true
+	return m, loadMyTeamsCmd(m.provider, req, m.config.Favorites, m.myTeamsRange())
+}
+
+// toggleFavorite stars or unstars a team in the current league and saves
+// the favorites.
+func (m Model) toggleFavorite(fav config.Favorite) (Model, tea.Cmd) {
+	if fav.TeamID == "" {
+		return m, nil
+	}
+	var starred bool
+	m.config, starred = m.config.ToggleFavorite(fav)
+	if starred {
+		m.notice = fmt.Sprintf("★ Starred %s", fav.Name)
+	} else {
+		m.notice = fmt.Sprintf("Removed %s from My Teams", fav.Name)
+	}
+	return m.saveFavorites()
+}
+
+// updateStarPrompt handles keys while asking which team of a game to star.
+func (m Model) updateStarPrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.starPrompt = false
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "a", "h":
+		if m.selectedSport == nil || m.selectedLeague == nil {
+			return m, nil
+		}
+		id, name := m.selectedTeam(msg.String() == "h")
+		return m.toggleFavorite(config.Favorite{
+			Sport:  m.selectedSport.ID,
+			League: m.selectedLeague.ID,
+			TeamID: id,
+			Name:   name,
+		})
+	}
+
+	return m, nil
+}
+
+// isFavorite reports whether a team in the selected league is starred.
+func (m Model) isFavorite(teamID string) bool {
+	if m.selectedSport == nil || m.selectedLeague == nil || teamID == "" {
+		return false
+	}
+	return m.config.IsFavorite(m.selectedSport.ID, m.selectedLeague.ID, teamID)
+}
+
+// favoriteMark prefixes starred team names.
+func (m Model) favoriteMark(teamID, name string) string {
+	if m.isFavorite(teamID) {
+		return "★ " + name
+	}
+	return name
+}
+
+// selectLeague points the selected sport and league at the given IDs so
+// views that depend on them (team pages, standings) work from My Teams.
+// Leagues missing from the sports list are synthesized from their IDs.
+func (m Model) selectLeague(sportID, leagueID string) Model {
+	for i := range m.sports {
+		if m.sports[i].ID != sportID {
+			continue
+		}
+		for j := range m.sports[i].Leagues {
+			if m.sports[i].Leagues[j].ID == leagueID {
+				m.selectedSport = &m.sports[i]
+				m.selectedLeague = &m.sports[i].Leagues[j]
+				return m
+			}
+		}
+	}
+	sportName := sportID
+	if sportName != "" {
+		sportName = strings.ToUpper(sportName[:1]) + sportName[1:]
+	}
+	m.selectedSport = &api.Sport{Name: sportName, ID: sportID}
+	m.selectedLeague = &api.League{Name: strings.ToUpper(leagueID), ID: leagueID}
+	return m
+}
+
+// leagueName returns the display name of a league in the sports list.
+func (m Model) leagueName(sportID, leagueID string) string {
+	for _, sport := range m.sports {
+		if sport.ID != sportID {
+			continue
+		}
+		for _, league := range sport.Leagues {
+			if league.ID == leagueID {
+				return league.Name
+			}
+		}
+	}
+	return strings.ToUpper(leagueID)
+}
+
+func (m Model) renderMyTeamsView() string {
+	title := titleStyle.Render("🏆 ⭐ My Teams")
+
+	if len(m.config.Favorites) == 0 {
+		empty := itemStyle.Render("No favorite teams yet. Press 'f' on a game card to star a team.")
+		help := m.renderHelp("esc back • q quit")
+		return lipgloss.JoinVertical(lipgloss.Left, title, "", empty, "", help)
+	}
+
+	var statusText string
+	if m.loadingMyTeams {
+		statusText = subtitleStyle.Render("Loading your teams...")
+	} else {
+		statusText = subtitleStyle.Render(fmt.Sprintf("Today and next games • Last updated: %s", m.lastUpdate.In(m.loc).Format(clockLayout)))
+	}
+
+	lines, _ := m.myTeamsLines()
+	start := m.myTeamsScrollOffset
+	if start > len(lines)-1 {
+		start = len(lines) - 1
+	}
+	end := start + m.myTeamsAvailableHeight()
+	if end > len(lines) {
+		end = len(lines)
+	}
+
+	help := m.renderHelp("↑/k up • ↓/j down • enter team page • f unstar • r refresh • esc back • q quit")
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		statusText,
+		"",
+		lipgloss.JoinVertical(lipgloss.Left, lines[start:end]...),
+		help,
+	)
+}
+
+// myTeamsLines renders the My Teams list, returning the line each
+// favorite starts on too.
+func (m Model) myTeamsLines() ([]string, []int) {
+	var lines []string
+	var starts []int
+	for i, fav := range m.config.Favorites {
+		starts = append(starts, len(lines))
+		cursor := "  "
+		style := teamStyle
+		if i == m.myTeamsCursor {
+			cursor = "❯ "
+			style = selectedItemStyle.UnsetPadding()
+		}
+		lines = append(lines, style.Render(fmt.Sprintf("  %s★ %s", cursor, fav.Name))+
+			statusStyle.Render(" • "+m.leagueName(fav.Sport, fav.League)))
+
+		if !m.loadingMyTeams {
+			lines = append(lines, m.favoriteGameLines(fav)...)
+		}
+		lines = append(lines, "")
+	}
+	return lines, starts
+}
+
+// myTeamsAvailableHeight returns how many lines of the My Teams list fit
+// on screen.
+func (m Model) myTeamsAvailableHeight() int {
+	available := m.height - 9
+	if available < 5 {
+		available = 5
+	}
+	return available
+}
+
+// scrollMyTeams moves the My Teams scroll offset so the highlighted team
+// and its games are on screen, or the top of them when they don't fit.
+func (m Model) scrollMyTeams() Model {
+	lines, starts := m.myTeamsLines()
+	if m.myTeamsCursor >= len(starts) {
+		m.myTeamsScrollOffset = 0
+		return m
+	}
+
+	first := starts[m.myTeamsCursor]
+	last := len(lines) - 1
+	if m.myTeamsCursor+1 < len(starts) {
+		last = starts[m.myTeamsCursor+1] - 1
+	}
+	if available := m.myTeamsAvailableHeight(); last >= m.myTeamsScrollOffset+available {
+		m.myTeamsScrollOffset = last - available + 1
+	}
+	if first < m.myTeamsScrollOffset {
+		m.myTeamsScrollOffset = first
+	}
+	return m
+}
+
+// favoriteGameLines renders a favorite's games today and its next game
+// after today from the loaded scoreboards.
+func (m Model) favoriteGameLines(fav config.Favorite) []string {
+	var games []api.Game
+	for _, result := range m.myTeamsResults {
+		if result.Sport != fav.Sport || result.League != fav.League {
+			continue
+		}
+		if result.Failed() {
+			return []string{errorStyle.Render(fmt.Sprintf("    Couldn't load games: %v", result.Err))}
+		}
+		for _, game := range result.Games {
+			if game.HomeTeam.ID == fav.TeamID || game.AwayTeam.ID == fav.TeamID {
+				games = append(games, game)
+			}
+		}
+	}
+
+	now := time.Now().In(m.loc)
+	var lines []string
+	var next *api.Game
+	for i, game := range games {
+		if sameDay(game.Date.In(m.loc), now) {
+			line, style := m.scheduleLine(game, fav.TeamID)
+			lines = append(lines, style.Render("    Today "+strings.TrimLeft(line, " ")))
+		} else if next == nil && game.Date.After(now) && !game.Completed {
+			next = &games[i]
+		}
+	}
+
+	if len(lines) == 0 {
+		lines = append(lines, statusStyle.Render("    No game today"))
+	}
+	if next != nil {
+		line, style := m.scheduleLine(*next, fav.TeamID)
+		lines = append(lines, style.Render("    Next  "+strings.TrimLeft(line, " ")))
+	} else {
+		lines = append(lines, statusStyle.Render(fmt.Sprintf("    No games in the next %d days", int(m.myTeamsRange().End.Sub(today(m.loc)).Hours()/24))))
+	}
+
+	return lines
+}
@@ -0,0 +1,45 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elliota43/sportsterminal/config"
+)
+
+func TestMyTeamsScroll(t *testing.T) {
+	var favorites []config.Favorite
+	for i := 1; i <= 12; i++ {
+		favorites = append(favorites, config.Favorite{Sport: "basketball", League: "nba", TeamID: fmt.Sprint(i), Name: fmt.Sprintf("Team %02d", i)})
+	}
+	m := NewModel(fakeProvider{}, Options{Config: config.Config{Favorites: favorites}})
+	m.state = myTeamsView
+	model, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 24})
+	m = model.(Model)
+
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	up := tea.KeyMsg{Type: tea.KeyUp}
+	keys := append(append([]tea.KeyMsg{}, repeatKey(down, 11)...), repeatKey(up, 11)...)
+	for i, key := range keys {
+		model, _ = m.Update(key)
+		m = model.(Model)
+		view := m.View()
+		name := favorites[m.myTeamsCursor].Name
+		if !strings.Contains(view, "❯ ★ "+name) {
+			t.Fatalf("key %d: highlighted %s is off screen:\n%s", i, name, view)
+		}
+	}
+	if m.myTeamsScrollOffset != 0 {
+		t.Errorf("scroll offset back at the top = %d, want 0", m.myTeamsScrollOffset)
+	}
+}
+
+func repeatKey(key tea.KeyMsg, n int) []tea.KeyMsg {
+	keys := make([]tea.KeyMsg, n)
+	for i := range keys {
+		keys[i] = key
+	}
+	return keys
+}
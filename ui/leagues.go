@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elliota43/sportsterminal/api"
+	"github.com/elliota43/sportsterminal/config"
+)
+
+type leaguesCheckedMsg struct {
+	invalid    []api.LeagueRef
+	discovered []api.Sport
+}
+
+// customSports turns the config's custom leagues into sports for
+// api.MergeLeagues.
+func customSports(leagues []config.CustomLeague) []api.Sport {
+	var sports []api.Sport
+	for _, league := range leagues {
+		sports = append(sports, api.CustomSport(league.Sport, league.League, league.Name))
+	}
+	return sports
+}
+
+// checkLeaguesCmd validates the custom leagues and, when enabled, probes
+// the league catalogue, concurrently.
+func checkLeaguesCmd(provider api.Provider, cfg config.Config) tea.Cmd {
+	return func() tea.Msg {
+		var msg leaguesCheckedMsg
+
+		discovered := make(chan []api.Sport, 1)
+		go func() {
+			if cfg.DiscoverLeagues {
+				discovered <- api.DiscoverLeagues(context.Background(), provider, api.LeagueCatalog)
+			} else {
+				discovered <- nil
+			}
+		}()
+
+		var refs []api.LeagueRef
+		for _, league := range cfg.CustomLeagues {
+			refs = append(refs, api.LeagueRef{Sport: league.Sport, League: league.League})
+		}
+		for i, err := range api.ValidateLeagues(context.Background(), provider, refs) {
+			if err != nil {
+				msg.invalid = append(msg.invalid, refs[i])
+			}
+		}
+
+		msg.discovered = <-discovered
+		return msg
+	}
+}
+
+// applyLeagueCheck drops custom leagues that failed validation from the
+// sports list and adds discovered ones.
+func (m Model) applyLeagueCheck(msg leaguesCheckedMsg) Model {
+	sports := api.MergeLeagues(m.sports, msg.discovered)
+
+	for _, ref := range msg.invalid {
+		for i := range sports {
+			if sports[i].ID != ref.Sport {
+				continue
+			}
+			var leagues []api.League
+			for _, league := range sports[i].Leagues {
+				if league.ID != ref.League {
+					leagues = append(leagues, league)
+				}
+			}
+			sports[i].Leagues = leagues
+		}
+	}
+
+	m.sports = enabledSports(sports, m.config)
+	if m.sportCursor >= len(m.sports) && m.sportCursor > 0 {
+		m.sportCursor = len(m.sports) - 1
+	}
+
+	if len(msg.invalid) > 0 {
+		var names []string
+		for _, ref := range msg.invalid {
+			names = append(names, ref.Sport+"/"+ref.League)
+		}
+		m.notice = fmt.Sprintf("Skipped custom leagues without a scoreboard: %s", strings.Join(names, ", "))
+	}
+
+	return m
+}
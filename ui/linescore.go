@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/elliota43/sportsterminal/api"
+)
+
+// lineScoreLines is the height of a rendered linescore grid plus the blank
+// line separating it from the scores above.
+const lineScoreLines = 4
+
+// lineScoreRow is one team's line in a linescore grid.
+type lineScoreRow struct {
+	name   string
+	scores []string
+	total  string
+	hits   string
+	errors string
+}
+
+func gameLineScoreRow(team api.Team) lineScoreRow {
+	name := team.ShortName
+	if name == "" {
+		name = team.Name
+	}
+	return lineScoreRow{name: name, scores: team.LineScores, total: team.Score, hits: team.Hits, errors: team.Errors}
+}
+
+func detailLineScoreRow(team api.TeamDetail) lineScoreRow {
+	name := team.ShortName
+	if name == "" {
+		name = team.Name
+	}
+	return lineScoreRow{name: name, scores: team.LineScores, total: team.Score, hits: team.Hits, errors: team.Errors}
+}
+
+// renderLineScore renders a classic linescore grid for two teams: one
+// column per period with a total, plus hits and errors for baseball. It
+// returns an empty string when there are no period scores yet.
+func renderLineScore(sport, league string, away, home lineScoreRow) string {
+	periods := len(away.scores)
+	if len(home.scores) > periods {
+		periods = len(home.scores)
+	}
+	if periods == 0 {
+		return ""
+	}
+
+	regulation := api.RegulationPeriods(sport, league)
+	columns := periods
+	if sport == "baseball" && columns < regulation {
+		// Show the full nine innings from the first pitch
+		columns = regulation
+	}
+
+	// Narrow enough for nine innings plus R/H/E inside a game card
+	const nameWidth = 14
+	const colWidth = 3
+	const totalWidth = colWidth + 1
+
+	var totals []string
+	if sport == "baseball" {
+		totals = []string{"R", "H", "E"}
+	} else {
+		totals = []string{"T"}
+	}
+
+	header := fmt.Sprintf("%-*s", nameWidth, "")
+	for i := 0; i < columns; i++ {
+		header += fmt.Sprintf("%*s", colWidth, api.PeriodLabel(sport, regulation, i))
+	}
+	for _, label := range totals {
+		header += fmt.Sprintf("%*s", totalWidth, label)
+	}
+
+	row := func(r lineScoreRow) string {
+		line := fmt.Sprintf("%-*s", nameWidth, truncate(r.name, nameWidth-1))
+		for i := 0; i < columns; i++ {
+			value := ""
+			if i < len(r.scores) {
+				value = r.scores[i]
+			}
+			line += fmt.Sprintf("%*s", colWidth, value)
+		}
+		line += fmt.Sprintf("%*s", totalWidth, r.total)
+		if sport == "baseball" {
+			line += fmt.Sprintf("%*s%*s", totalWidth, r.hits, totalWidth, r.errors)
+		}
+		return line
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		lipgloss.NewStyle().Bold(true).Foreground(primaryColor).Render(strings.TrimRight(header, " ")),
+		lipgloss.NewStyle().Foreground(textColor).Render(row(away)),
+		lipgloss.NewStyle().Foreground(textColor).Render(row(home)),
+	)
+}
@@ -0,0 +1,192 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/elliota43/sportsterminal/api"
+)
+
+type liveLoadedMsg struct {
+	id      uint64
+	results []api.LeagueGames
+}
+
+// liveGame is a live game tagged with the league it was found in.
+type liveGame struct {
+	api.LeagueRef
+	api.Game
+}
+
+func loadLiveCmd(provider api.Provider, req request, leagues []api.LeagueRef, loc *time.Location) tea.Cmd {
+	return func() tea.Msg {
+		return liveLoadedMsg{id: req.id, results: api.FetchScoreboards(req.ctx, provider, leagues, api.Day(today(loc)))}
+	}
+}
+
+// allLeagues returns every league in the sports list.
+func (m Model) allLeagues() []api.LeagueRef {
+	var leagues []api.LeagueRef
+	for _, sport := range m.sports {
+		for _, league := range sport.Leagues {
+			leagues = append(leagues, api.LeagueRef{Sport: sport.ID, League: league.ID})
+		}
+	}
+	return leagues
+}
+
+// openLive switches to the All Live Games dashboard.
+func (m Model) openLive() (Model, tea.Cmd) {
+	m, req := m.beginRequest()
+	m.state = liveView
+	m.closeGames = false
+	m.liveCursor = 0
+	m.err = nil
+	m.loadingLive = true
+	return m, loadLiveCmd(m.provider, req, m.allLeagues(), m.loc)
+}
+
+// liveGames returns the games in progress across every loaded league, in
+// sports list order. The Close Games view lists games matching an alert
+// rule instead.
+func (m Model) liveGames() []liveGame {
+	var games []liveGame
+	for _, result := range m.liveResults {
+		for _, game := range result.Games {
+			match := game.IsLive
+			if m.closeGames {
+				match = len(m.gameAlerts(result.LeagueRef, game)) > 0
+			}
+			if match {
+				games = append(games, liveGame{LeagueRef: result.LeagueRef, Game: game})
+			}
+		}
+	}
+	return games
+}
+
+// failedLeagues names the leagues whose scoreboards couldn't be loaded.
+func (m Model) failedLeagues() []string {
+	var names []string
+	for _, result := range m.liveResults {
+		if result.Failed() {
+			names = append(names, m.leagueName(result.Sport, result.League))
+		}
+	}
+	return names
+}
+
+// openLiveGame opens the detail view for the highlighted live game.
+func (m Model) openLiveGame() (Model, tea.Cmd) {
+	games := m.liveGames()
+	if m.liveCursor >= len(games) {
+		return m, nil
+	}
+	game := games[m.liveCursor]
+	m = m.selectLeague(game.Sport, game.League)
+	m, req := m.beginRequest()
+	m.state = gameDetailView
+	m.detailReturn = liveView
+	m.loadingDetail = true
+	m.detailScrollOffset = 0
+	m.detailAthleteCursor = -1
+	m.detailID = game.ID
+	return m, loadGameDetailCmd(m.provider, req, game.Sport, game.League, game.ID)
+}
+
+func (m Model) renderLiveView() string {
+	title := titleStyle.Render("🏆 🔴 All Live Games")
+	if m.closeGames {
+		title = titleStyle.Render("🏆 🚨 Close Games")
+	}
+
+	var statusText string
+	if m.loadingLive && m.liveResults == nil {
+		statusText = subtitleStyle.Render(fmt.Sprintf("Checking %d leagues...", len(m.allLeagues())))
+	} else {
+		refresh := "Auto-refresh off"
+		if m.autoRefresh {
+			refresh = fmt.Sprintf("Auto-refresh every %s", m.refreshInterval)
+		}
+		statusText = subtitleStyle.Render(fmt.Sprintf("%s • Last updated: %s", refresh, m.lastUpdate.In(m.loc).Format(clockLayout)))
+	}
+
+	games := m.liveGames()
+	var lines []string
+	cursorLine := 0
+	var current api.LeagueRef
+	for i, game := range games {
+		if i == 0 || game.LeagueRef != current {
+			current = game.LeagueRef
+			if i > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, dayHeaderStyle.Render(fmt.Sprintf("%s %s", getSportIcon(game.Sport), m.leagueName(game.Sport, game.League))))
+		}
+
+		cursor := "  "
+		style := teamStyle
+		if i == m.liveCursor {
+			cursor = "❯ "
+			style = selectedItemStyle.UnsetPadding()
+			cursorLine = len(lines)
+		}
+		score := fmt.Sprintf("%s%s %s - %s %s", cursor,
+			game.AwayTeam.ShortName, game.AwayTeam.Score,
+			game.HomeTeam.Score, game.HomeTeam.ShortName)
+		status := game.StatusDetail
+		if status == "" {
+			status = game.Status
+		}
+		line := style.Render("  "+score) + "  "
+		if game.IsLive {
+			line += liveStyle.Render("🔴 " + status)
+		} else {
+			line += statusStyle.Render(status)
+		}
+		if m.closeGames {
+			line += "  " + alertBadge(m.gameAlerts(game.LeagueRef, game.Game))
+		}
+		lines = append(lines, line)
+	}
+
+	if len(games) == 0 && !m.loadingLive {
+		if m.closeGames {
+			lines = append(lines, itemStyle.Render("No games match an alert right now."))
+		} else {
+			lines = append(lines, itemStyle.Render("No games are live right now."))
+		}
+	}
+	if failed := m.failedLeagues(); len(failed) > 0 {
+		lines = append(lines, "", errorStyle.Render("Couldn't load: "+strings.Join(failed, ", ")))
+	}
+
+	// Keep the highlighted game on screen
+	available := m.height - 8
+	if available < 5 {
+		available = 5
+	}
+	start := 0
+	if cursorLine >= available {
+		start = cursorLine - available + 1
+	}
+	end := start + available
+	if end > len(lines) {
+		end = len(lines)
+	}
+
+	help := m.renderHelp("↑/k up • ↓/j down • enter game details • r refresh • esc back • q quit")
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		statusText,
+		"",
+		lipgloss.JoinVertical(lipgloss.Left, lines[start:end]...),
+		"",
+		help,
+	)
+}
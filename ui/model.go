@@ -1,13 +1,18 @@
 package ui
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/elliota43/sportsterminal/alerts"
 	"github.com/elliota43/sportsterminal/api"
+	"github.com/elliota43/sportsterminal/config"
+	"github.com/elliota43/sportsterminal/notify"
+	"github.com/elliota43/sportsterminal/watch"
 )
 
 type viewState int
@@ -17,74 +22,332 @@ const (
 	leagueView
 	gamesView
 	gameDetailView
+	standingsView
+	teamView
+	rosterView
+	playerView
+	myTeamsView
+	liveView
+	notificationsView
 )
 
 type Model struct {
-	state              viewState
-	selectedSport      *api.Sport
-	selectedLeague     *api.League
-	games              []api.Game
-	selectedGameDetail *api.GameDetail
-	sportCursor        int
-	leagueCursor       int
-	gameCursor         int
-	gameScrollOffset   int
-	detailScrollOffset int
-	width              int
-	height             int
-	loading            bool
-	loadingDetail      bool
-	showUpcoming       bool
-	err                error
-	lastUpdate         time.Time
-	autoRefresh        bool
+	provider                  api.Provider
+	config                    config.Config
+	configPath                string
+	sports                    []api.Sport
+	state                     viewState
+	selectedSport             *api.Sport
+	selectedLeague            *api.League
+	games                     []api.Game
+	selectedGameDetail        *api.GameDetail
+	detailID                  string
+	standings                 *api.Standings
+	team                      *api.TeamInfo
+	teamID                    string
+	teamSchedule              []api.Game
+	roster                    []api.Athlete
+	player                    *api.AthleteProfile
+	playerID                  string
+	sportCursor               int
+	leagueCursor              int
+	gameCursor                int
+	gameScrollOffset          int
+	detailScrollOffset        int
+	detailReturn              viewState
+	standingsScrollOffset     int
+	standingsSort             int
+	standingsAscending        bool
+	standingsReturn           viewState
+	teamScrollOffset          int
+	teamReturn                viewState
+	rosterCursor              int
+	rosterScrollOffset        int
+	playerScrollOffset        int
+	playerReturn              viewState
+	detailAthleteCursor       int
+	myTeamsResults            []api.LeagueGames
+	myTeamsCursor             int
+	liveResults               []api.LeagueGames
+	liveCursor                int
+	closeGames                bool
+	starPrompt                bool
+	notice                    string
+	width                     int
+	height                    int
+	loading                   bool
+	loadingDetail             bool
+	loadingStandings          bool
+	loadingTeam               bool
+	loadingRoster             bool
+	loadingPlayer             bool
+	loadingMyTeams            bool
+	loadingLive               bool
+	req                       request
+	cancelReq                 context.CancelFunc
+	followed                  map[api.LeagueRef][]api.Game
+	followedLeaders           watch.Leaders
+	leaders                   watch.Leaders
+	flash                     map[string]time.Time
+	notifications             []notification
+	toast                     notification
+	toastUntil                time.Time
+	toastMore                 int
+	bell                      bool
+	notificationsReturn       viewState
+	notificationsScrollOffset int
+	myTeamsScrollOffset       int
+	notifier                  notify.Notifier
+	desktopErrShown           bool
+	savingConfig              bool
+	saveQueued                bool
+	stale                     *api.StaleError
+	retryAt                   time.Time
+	retries                   int
+	offline                   bool
+	alerts                    *alerts.Engine
+	alerted                   map[string]bool
+	showUpcoming              bool
+	showLineScores            bool
+	selectedDate              time.Time
+	loc                       *time.Location
+	datePicker                bool
+	dateInput                 string
+	dateErr                   error
+	window                    api.Window
+	err                       error
+	lastUpdate                time.Time
+	autoRefresh               bool
+	refreshInterval           time.Duration
 }
 
 type gamesLoadedMsg struct {
+	id    uint64
 	games []api.Game
 	err   error
 }
 
 type gameDetailLoadedMsg struct {
+	id     uint64
 	detail *api.GameDetail
 	err    error
 }
 
 type tickMsg time.Time
 
-func NewModel() Model {
-	return Model{
-		state:       sportView,
-		autoRefresh: true,
-		lastUpdate:  time.Now(),
+// clockLayout formats times of day, following the config's 12h/24h clock.
+var clockLayout = "3:04 PM"
+
+// Options configures a Model.
+type Options struct {
+	// Window is the range of days shown when upcoming games are toggled on.
+	Window api.Window
+
+	// Config holds favorites and other persisted settings: refresh
+	// interval, start view, clock, enabled leagues and theme.
+	Config config.Config
+
+	// ConfigPath is where Config is saved when favorites change.
+	ConfigPath string
+
+	// Notifier delivers desktop notifications when they are enabled in
+	// Config. Nil disables them.
+	Notifier notify.Notifier
+
+	// Alerts are the rules that highlight games and feed the Close Games
+	// view. Nil disables alerts.
+	Alerts *alerts.Engine
+
+	// Offline means provider serves saved data only, so leagues aren't
+	// checked against the network on startup.
+	Offline bool
+
+	// Location is the time zone days and times are shown in. Nil means
+	// time.Local.
+	Location *time.Location
+}
+
+func NewModel(provider api.Provider, opts Options) Model {
+	cfg := opts.Config
+
+	setTheme(cfg.Theme)
+	clockLayout = cfg.ClockLayout()
+
+	loc := opts.Location
+	if loc == nil {
+		loc = time.Local
+	}
+
+	m := Model{
+		provider:        provider,
+		sports:          enabledSports(api.MergeLeagues(provider.Leagues(), customSports(cfg.CustomLeagues)), cfg),
+		window:          opts.Window,
+		config:          cfg,
+		configPath:      opts.ConfigPath,
+		alerts:          opts.Alerts,
+		offline:         opts.Offline,
+		state:           sportView,
+		refreshInterval: cfg.Refresh(),
+		autoRefresh:     cfg.Refresh() > 0,
+		lastUpdate:      time.Now(),
+		loc:             loc,
+		selectedDate:    today(loc),
+	}
+
+	if cfg.Notifications.Desktop {
+		m.notifier = opts.Notifier
+		if m.notifier == nil {
+			m.notice = "Desktop notifications are on, but neither notify-send nor gdbus was found"
+		}
 	}
+
+	// Open the configured start view. Its data is fetched by Init.
+	switch cfg.StartView {
+	case config.StartMyTeams:
+		m, _ = m.beginRequest()
+		m.state = myTeamsView
+		m.loadingMyTeams = len(cfg.Favorites) > 0
+	case config.StartLive:
+		m, _ = m.beginRequest()
+		m.state = liveView
+		m.loadingLive = true
+	case config.StartGames:
+		if sport, league, ok := config.SplitLeague(cfg.DefaultLeague); ok {
+			m = m.selectLeague(sport, league)
+			m, _ = m.beginRequest()
+			m.state = gamesView
+			m.loading = true
+		}
+	}
+
+	return m
+}
+
+// enabledSports filters the provider's leagues down to those enabled in
+// the config, dropping sports left without leagues.
+func enabledSports(sports []api.Sport, cfg config.Config) []api.Sport {
+	var enabled []api.Sport
+	for _, sport := range sports {
+		var leagues []api.League
+		for _, league := range sport.Leagues {
+			if cfg.LeagueEnabled(sport.ID, league.ID) {
+				leagues = append(leagues, league)
+			}
+		}
+		if len(leagues) > 0 {
+			sport.Leagues = leagues
+			enabled = append(enabled, sport)
+		}
+	}
+	return enabled
 }
 
 func (m Model) Init() tea.Cmd {
-	return tea.Batch(
-		tea.EnterAltScreen,
-		tickCmd(),
-	)
+	cmds := []tea.Cmd{tea.EnterAltScreen, m.tickCmd()}
+
+	if leagues := m.followedLeagues(); len(leagues) > 0 {
+		// Baseline for score change notifications about followed teams
+		cmds = append(cmds, loadFollowedCmd(m.provider, leagues, m.loc))
+	}
+
+	if (len(m.config.CustomLeagues) > 0 || m.config.DiscoverLeagues) && !m.offline {
+		cmds = append(cmds, checkLeaguesCmd(m.provider, m.config))
+	}
+
+	// The start view's request was begun by NewModel
+	switch {
+	case m.loadingMyTeams:
+		cmds = append(cmds, loadMyTeamsCmd(m.provider, m.req, m.config.Favorites, m.myTeamsRange()))
+	case m.loadingLive:
+		cmds = append(cmds, loadLiveCmd(m.provider, m.req, m.allLeagues(), m.loc))
+	case m.loading:
+		cmds = append(cmds, loadGamesCmd(m.provider, m.req, m.selectedSport.ID, m.selectedLeague.ID, m.gamesRange()))
+	}
+
+	return tea.Batch(cmds...)
 }
 
-func tickCmd() tea.Cmd {
-	return tea.Tick(30*time.Second, func(t time.Time) tea.Msg {
+// tickCmd schedules the next auto-refresh, or nothing when auto-refresh
+// is off.
+func (m Model) tickCmd() tea.Cmd {
+	if !m.autoRefresh {
+		return nil
+	}
+	return tea.Tick(m.refreshInterval, func(t time.Time) tea.Msg {
 		return tickMsg(t)
 	})
 }
 
-func loadGamesCmd(sport, league string, showUpcoming bool) tea.Cmd {
+func loadGamesCmd(provider api.Provider, req request, sport, league string, dates api.DateRange) tea.Cmd {
 	return func() tea.Msg {
-		games, err := api.GetGamesWithOptions(sport, league, showUpcoming)
-		return gamesLoadedMsg{games: games, err: err}
+		games, err := provider.Scoreboard(req.ctx, sport, league, dates)
+		return gamesLoadedMsg{id: req.id, games: games, err: err}
 	}
 }
 
-func loadGameDetailCmd(sport, league, eventID string) tea.Cmd {
+func loadGameDetailCmd(provider api.Provider, req request, sport, league, eventID string) tea.Cmd {
 	return func() tea.Msg {
-		detail, err := api.GetGameDetail(sport, league, eventID)
-		return gameDetailLoadedMsg{detail: detail, err: err}
+		detail, err := provider.Summary(req.ctx, sport, league, eventID)
+		return gameDetailLoadedMsg{id: req.id, detail: detail, err: err}
+	}
+}
+
+// gamesRange returns the days the games view currently covers.
+func (m Model) gamesRange() api.DateRange {
+	if m.showUpcoming {
+		return m.window.Range(m.selectedDate)
+	}
+	return api.Day(m.selectedDate)
+}
+
+// reloadGames resets the games list and fetches the current range.
+func (m Model) reloadGames() (Model, tea.Cmd) {
+	m, req := m.beginRequest()
+	m.loading = true
+	m.gameCursor = 0
+	m.gameScrollOffset = 0
+	return m, loadGamesCmd(m.provider, req, m.selectedSport.ID, m.selectedLeague.ID, m.gamesRange())
+}
+
+// refresh reloads the current view's data.
+func (m Model) refresh() (Model, tea.Cmd) {
+	switch m.state {
+	case gamesView:
+		if m.selectedSport != nil && m.selectedLeague != nil {
+			m, req := m.beginRequest()
+			m.loading = true
+			return m, loadGamesCmd(m.provider, req, m.selectedSport.ID, m.selectedLeague.ID, m.gamesRange())
+		}
+	case standingsView:
+		m, req := m.beginRequest()
+		m.loadingStandings = true
+		return m, loadStandingsCmd(m.provider, req, m.selectedSport.ID, m.selectedLeague.ID)
+	case myTeamsView:
+		return m.openMyTeams()
+	case liveView:
+		m, req := m.beginRequest()
+		m.loadingLive = true
+		return m, loadLiveCmd(m.provider, req, m.allLeagues(), m.loc)
+	case gameDetailView:
+		m, req := m.beginRequest()
+		m.loadingDetail = true
+		return m, loadGameDetailCmd(m.provider, req, m.selectedSport.ID, m.selectedLeague.ID, m.detailID)
+	case teamView:
+		m, req := m.beginRequest()
+		m.loadingTeam = true
+		return m, loadTeamCmd(m.provider, req, m.selectedSport.ID, m.selectedLeague.ID, m.teamID)
+	case rosterView:
+		if m.team != nil {
+			m, req := m.beginRequest()
+			m.loadingRoster = true
+			return m, loadRosterCmd(m.provider, req, m.selectedSport.ID, m.selectedLeague.ID, m.team.ID)
+		}
+	case playerView:
+		m, req := m.beginRequest()
+		m.loadingPlayer = true
+		return m, loadPlayerCmd(m.provider, req, m.selectedSport.ID, m.selectedLeague.ID, m.playerID)
 	}
+	return m, nil
 }
 
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -92,14 +355,30 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		if m.state == myTeamsView {
+			m = m.scrollMyTeams()
+		}
 		return m, nil
 
 	case tea.KeyMsg:
+		if m.datePicker {
+			return m.updateDatePicker(msg)
+		}
+		if m.starPrompt {
+			return m.updateStarPrompt(msg)
+		}
+		m.notice = ""
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
 
 		case "esc", "backspace":
+			// The screen being left stops loading. The notification
+			// history returns to a screen whose load is still wanted.
+			if m.state != notificationsView {
+				m = m.abandonRequest()
+			}
 			switch m.state {
 			case leagueView:
 				m.state = sportView
@@ -110,17 +389,162 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.gameScrollOffset = 0
 				m.games = nil
 			case gameDetailView:
-				m.state = gamesView
+				m.state = m.detailReturn
 				m.selectedGameDetail = nil
 				m.detailScrollOffset = 0
+				m.err = nil
+			case standingsView:
+				m.state = m.standingsReturn
+				m.standings = nil
+				m.err = nil
+			case teamView:
+				m.state = m.teamReturn
+				m.team = nil
+				m.teamSchedule = nil
+				m.err = nil
+			case rosterView:
+				m.state = teamView
+				m.roster = nil
+				m.err = nil
+			case playerView:
+				m.state = m.playerReturn
+				m.player = nil
+				m.err = nil
+			case myTeamsView:
+				m.state = sportView
+				m.myTeamsResults = nil
+			case liveView:
+				m.state = sportView
+				m.liveResults = nil
+				m.closeGames = false
+			case notificationsView:
+				m.state = m.notificationsReturn
 			}
 			return m, nil
 
 		case "r":
 			// Manual refresh
-			if m.state == gamesView && m.selectedSport != nil && m.selectedLeague != nil {
-				m.loading = true
-				return m, loadGamesCmd(m.selectedSport.ID, m.selectedLeague.ID, m.showUpcoming)
+			return m.refresh()
+
+		case "m":
+			// Open the My Teams dashboard
+			if m.state == sportView {
+				return m.openMyTeams()
+			}
+			return m, nil
+
+		case "n":
+			// Open the notification history
+			return m.openNotifications(), nil
+
+		case "c":
+			// Clear the notification history
+			if m.state == notificationsView {
+				m.notifications = nil
+				m.notificationsScrollOffset = 0
+			}
+			return m, nil
+
+		case "L":
+			// Open the All Live Games dashboard
+			if m.state == sportView {
+				return m.openLive()
+			}
+			return m, nil
+
+		case "C":
+			// Open the Close Games view of games matching an alert
+			if m.state == sportView && m.alerts != nil {
+				return m.openCloseGames()
+			}
+			return m, nil
+
+		case "f":
+			// Star a team from a game card, or unstar one in My Teams
+			switch m.state {
+			case gamesView, gameDetailView:
+				m.starPrompt = true
+			case myTeamsView:
+				if m.myTeamsCursor < len(m.config.Favorites) {
+					var cmd tea.Cmd
+					m, cmd = m.toggleFavorite(m.config.Favorites[m.myTeamsCursor])
+					if m.myTeamsCursor >= len(m.config.Favorites) && m.myTeamsCursor > 0 {
+						m.myTeamsCursor--
+					}
+					return m.scrollMyTeams(), cmd
+				}
+			}
+			return m, nil
+
+		case "s":
+			// Open standings for the highlighted or selected league
+			switch m.state {
+			case leagueView:
+				if m.selectedSport != nil && m.leagueCursor < len(m.selectedSport.Leagues) {
+					m.selectedLeague = &m.selectedSport.Leagues[m.leagueCursor]
+					return m.openStandings()
+				}
+			case gamesView:
+				if m.selectedSport != nil && m.selectedLeague != nil {
+					return m.openStandings()
+				}
+			}
+			return m, nil
+
+		case "a", "h":
+			// Open the away or home team's page
+			if m.state == gamesView || m.state == gameDetailView {
+				id, _ := m.selectedTeam(msg.String() == "h")
+				return m.openTeam(id)
+			}
+			return m, nil
+
+		case "p":
+			// Browse the roster of the open team
+			if m.state == teamView {
+				return m.openRoster()
+			}
+			return m, nil
+
+		case "x":
+			// Toggle linescore grids on game cards
+			if m.state == gamesView {
+				m.showLineScores = !m.showLineScores
+				for m.gameCursor >= m.gameScrollOffset+m.calculateVisibleGames() {
+					m.gameScrollOffset++
+				}
+			}
+			return m, nil
+
+		case "tab", "shift+tab":
+			// Highlight the next or previous player in the game detail view
+			if m.state == gameDetailView {
+				athletes := m.detailAthletes()
+				if len(athletes) > 0 {
+					if msg.String() == "tab" {
+						m.detailAthleteCursor = (m.detailAthleteCursor + 1) % len(athletes)
+					} else if m.detailAthleteCursor <= 0 {
+						m.detailAthleteCursor = len(athletes) - 1
+					} else {
+						m.detailAthleteCursor--
+					}
+					m = m.scrollToDetailAthlete()
+				}
+			}
+			return m, nil
+
+		case "o", "O":
+			// Cycle the standings sort column, or reverse the order
+			if m.state == standingsView && m.selectedSport != nil {
+				if msg.String() == "O" {
+					m.standingsAscending = !m.standingsAscending
+				} else {
+					m.standingsSort++
+					if m.standingsSort >= len(standingsColumns(m.selectedSport.ID)) {
+						m.standingsSort = -1
+					}
+					m.standingsAscending = false
+				}
 			}
 			return m, nil
 
@@ -128,10 +552,35 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Toggle upcoming games
 			if m.state == gamesView && m.selectedSport != nil && m.selectedLeague != nil {
 				m.showUpcoming = !m.showUpcoming
-				m.loading = true
-				m.gameCursor = 0
-				m.gameScrollOffset = 0
-				return m, loadGamesCmd(m.selectedSport.ID, m.selectedLeague.ID, m.showUpcoming)
+				return m.reloadGames()
+			}
+			return m, nil
+
+		case "[", "]":
+			// Step to the previous or next day
+			if m.state == gamesView && m.selectedSport != nil && m.selectedLeague != nil {
+				step := 1
+				if msg.String() == "[" {
+					step = -1
+				}
+				m.selectedDate = m.selectedDate.AddDate(0, 0, step)
+				return m.reloadGames()
+			}
+			return m, nil
+
+		case "t":
+			// Jump back to today
+			if m.state == gamesView && m.selectedSport != nil && m.selectedLeague != nil {
+				m.selectedDate = today(m.loc)
+				return m.reloadGames()
+			}
+			return m, nil
+
+		case "d":
+			// Open the date picker
+			if m.state == gamesView && m.selectedSport != nil && m.selectedLeague != nil {
+				m.datePicker = true
+				m.dateInput = ""
 			}
 			return m, nil
 
@@ -157,13 +606,45 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if m.detailScrollOffset > 0 {
 					m.detailScrollOffset--
 				}
+			case standingsView:
+				if m.standingsScrollOffset > 0 {
+					m.standingsScrollOffset--
+				}
+			case teamView:
+				if m.teamScrollOffset > 0 {
+					m.teamScrollOffset--
+				}
+			case rosterView:
+				if m.rosterCursor > 0 {
+					m.rosterCursor--
+					if m.rosterCursor < m.rosterScrollOffset {
+						m.rosterScrollOffset = m.rosterCursor
+					}
+				}
+			case playerView:
+				if m.playerScrollOffset > 0 {
+					m.playerScrollOffset--
+				}
+			case myTeamsView:
+				if m.myTeamsCursor > 0 {
+					m.myTeamsCursor--
+					m = m.scrollMyTeams()
+				}
+			case liveView:
+				if m.liveCursor > 0 {
+					m.liveCursor--
+				}
+			case notificationsView:
+				if m.notificationsScrollOffset > 0 {
+					m.notificationsScrollOffset--
+				}
 			}
 			return m, nil
 
 		case "down", "j":
 			switch m.state {
 			case sportView:
-				if m.sportCursor < len(api.AvailableSports)-1 {
+				if m.sportCursor < len(m.sports)-1 {
 					m.sportCursor++
 				}
 			case leagueView:
@@ -173,22 +654,47 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case gamesView:
 				if m.gameCursor < len(m.games)-1 {
 					m.gameCursor++
-					// Calculate visible games and scroll down if needed
-					visibleGames := m.calculateVisibleGames()
-					if m.gameCursor >= m.gameScrollOffset+visibleGames {
-						m.gameScrollOffset = m.gameCursor - visibleGames + 1
+					// Scroll down until the cursor fits in the visible window
+					for m.gameCursor >= m.gameScrollOffset+m.calculateVisibleGames() {
+						m.gameScrollOffset++
 					}
 				}
 			case gameDetailView:
 				m.detailScrollOffset++
+			case standingsView:
+				m.standingsScrollOffset++
+			case teamView:
+				m.teamScrollOffset++
+			case rosterView:
+				if m.rosterCursor < len(m.roster)-1 {
+					m.rosterCursor++
+					if m.rosterCursor >= m.rosterScrollOffset+m.visibleRosterRows() {
+						m.rosterScrollOffset = m.rosterCursor - m.visibleRosterRows() + 1
+					}
+				}
+			case playerView:
+				m.playerScrollOffset++
+			case myTeamsView:
+				if m.myTeamsCursor < len(m.config.Favorites)-1 {
+					m.myTeamsCursor++
+					m = m.scrollMyTeams()
+				}
+			case liveView:
+				if m.liveCursor < len(m.liveGames())-1 {
+					m.liveCursor++
+				}
+			case notificationsView:
+				if m.notificationsScrollOffset < len(m.notifications)-1 {
+					m.notificationsScrollOffset++
+				}
 			}
 			return m, nil
 
 		case "enter", "right", "l":
 			switch m.state {
 			case sportView:
-				if m.sportCursor < len(api.AvailableSports) {
-					m.selectedSport = &api.AvailableSports[m.sportCursor]
+				if m.sportCursor < len(m.sports) {
+					m.selectedSport = &m.sports[m.sportCursor]
 					m.state = leagueView
 					m.leagueCursor = 0
 				}
@@ -196,40 +702,183 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if m.selectedSport != nil && m.leagueCursor < len(m.selectedSport.Leagues) {
 					m.selectedLeague = &m.selectedSport.Leagues[m.leagueCursor]
 					m.state = gamesView
-					m.loading = true
-					m.gameCursor = 0
-					m.gameScrollOffset = 0
 					m.showUpcoming = false // Reset to current games when changing leagues
-					return m, loadGamesCmd(m.selectedSport.ID, m.selectedLeague.ID, m.showUpcoming)
+					m.selectedDate = today(m.loc)
+					return m.reloadGames()
 				}
 			case gamesView:
 				if m.gameCursor < len(m.games) {
+					m, req := m.beginRequest()
 					m.state = gameDetailView
+					m.detailReturn = gamesView
 					m.loadingDetail = true
 					m.detailScrollOffset = 0
-					selectedGame := m.games[m.gameCursor]
-					return m, loadGameDetailCmd(m.selectedSport.ID, m.selectedLeague.ID, selectedGame.ID)
+					m.detailAthleteCursor = -1
+					m.detailID = m.games[m.gameCursor].ID
+					return m, loadGameDetailCmd(m.provider, req, m.selectedSport.ID, m.selectedLeague.ID, m.detailID)
+				}
+			case gameDetailView:
+				athletes := m.detailAthletes()
+				if m.detailAthleteCursor >= 0 && m.detailAthleteCursor < len(athletes) {
+					return m.openPlayer(athletes[m.detailAthleteCursor])
+				}
+			case rosterView:
+				if m.rosterCursor < len(m.roster) {
+					return m.openPlayer(m.roster[m.rosterCursor].ID)
+				}
+			case myTeamsView:
+				if m.myTeamsCursor < len(m.config.Favorites) {
+					fav := m.config.Favorites[m.myTeamsCursor]
+					m = m.selectLeague(fav.Sport, fav.League)
+					return m.openTeam(fav.TeamID)
 				}
+			case liveView:
+				return m.openLiveGame()
 			}
 			return m, nil
 		}
 
 	case gamesLoadedMsg:
+		if !m.current(msg.id) {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m, msg.err = m.loaded(msg.err)
+		if msg.err == nil {
+			var alertCmd tea.Cmd
+			if m.stale == nil {
+				// Games from the cache may be older than those on
+				// screen, so they aren't news
+				m, cmd = m.diffGames(msg.games)
+			}
+			if ref, ok := m.selectedRef(); ok {
+				m, alertCmd = m.checkAlerts(ref, msg.games)
+			}
+			cmd = tea.Batch(cmd, alertCmd)
+		}
 		m.loading = false
 		m.games = msg.games
 		m.err = msg.err
-		m.lastUpdate = time.Now()
+		m.lastUpdate = m.updatedAt()
+		m, retryCmd := m.scheduleRetry()
+		return m, tea.Batch(cmd, retryCmd)
+
+	case followedLoadedMsg:
+		m, cmd := m.updateFollowed(msg)
+		m, alertCmd := m.checkResultAlerts(msg.results)
+		return m, tea.Batch(cmd, alertCmd)
+
+	case desktopNotifiedMsg:
+		return m.desktopNotified(msg), nil
+
+	case flashExpiredMsg:
+		return m.expireFlashes(), nil
+
+	case toastExpiredMsg:
+		// Nothing to update; the redraw drops the expired toast
+		return m, nil
+
+	case bellRungMsg:
+		m.bell = false
 		return m, nil
 
 	case gameDetailLoadedMsg:
+		if !m.current(msg.id) {
+			return m, nil
+		}
 		m.loadingDetail = false
 		m.selectedGameDetail = msg.detail
-		m.err = msg.err
-		return m, nil
+		m, m.err = m.loaded(msg.err)
+		m, alertCmd := m.checkDetailAlerts(msg.detail)
+		m, retryCmd := m.scheduleRetry()
+		return m, tea.Batch(alertCmd, retryCmd)
+
+	case standingsLoadedMsg:
+		if !m.current(msg.id) {
+			return m, nil
+		}
+		m.loadingStandings = false
+		m.standings = msg.standings
+		m, m.err = m.loaded(msg.err)
+		return m.scheduleRetry()
+
+	case teamLoadedMsg:
+		if !m.current(msg.id) {
+			return m, nil
+		}
+		m.loadingTeam = false
+		m.team = msg.team
+		m.teamSchedule = msg.schedule
+		m, m.err = m.loaded(msg.err)
+		return m.scheduleRetry()
+
+	case rosterLoadedMsg:
+		if !m.current(msg.id) {
+			return m, nil
+		}
+		m.loadingRoster = false
+		m.roster = msg.roster
+		m, m.err = m.loaded(msg.err)
+		return m.scheduleRetry()
+
+	case playerLoadedMsg:
+		if !m.current(msg.id) {
+			return m, nil
+		}
+		m.loadingPlayer = false
+		m.player = msg.player
+		m, m.err = m.loaded(msg.err)
+		return m.scheduleRetry()
+
+	case myTeamsLoadedMsg:
+		if !m.current(msg.id) {
+			return m, nil
+		}
+		m.loadingMyTeams = false
+		m.myTeamsResults = msg.results
+		m = m.loadedResults(msg.results)
+		m.lastUpdate = m.updatedAt()
+		return m.scrollMyTeams(), nil
+
+	case liveLoadedMsg:
+		if !m.current(msg.id) {
+			return m, nil
+		}
+		m.loadingLive = false
+		m.liveResults = msg.results
+		m = m.loadedResults(msg.results)
+		m.lastUpdate = m.updatedAt()
+		if n := len(m.liveGames()); m.liveCursor >= n && n > 0 {
+			m.liveCursor = n - 1
+		}
+		return m.checkResultAlerts(msg.results)
+
+	case retryMsg:
+		return m.retry(msg)
+
+	case leaguesCheckedMsg:
+		return m.applyLeagueCheck(msg), nil
+
+	case configSavedMsg:
+		return m.configSaved(msg)
 
 	case tickMsg:
+		cmds := []tea.Cmd{m.tickCmd()}
+
+		// Follow favorite teams' games from any screen
+		if leagues := m.followedLeagues(); m.autoRefresh && len(leagues) > 0 {
+			cmds = append(cmds, loadFollowedCmd(m.provider, leagues, m.loc))
+		}
+
+		// Keep the All Live Games dashboard current
+		if m.autoRefresh && m.state == liveView && !m.loadingLive {
+			var req request
+			m, req = m.beginRequest()
+			m.loadingLive = true
+			cmds = append(cmds, loadLiveCmd(m.provider, req, m.allLeagues(), m.loc))
+		}
 		// Auto-refresh live games
-		if m.autoRefresh && m.state == gamesView && m.selectedSport != nil && m.selectedLeague != nil {
+		if m.autoRefresh && m.state == gamesView && !m.loading && m.selectedSport != nil && m.selectedLeague != nil {
 			hasLiveGames := false
 			for _, game := range m.games {
 				if game.IsLive {
@@ -238,13 +887,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 			}
 			if hasLiveGames {
-				return m, tea.Batch(
-					loadGamesCmd(m.selectedSport.ID, m.selectedLeague.ID, m.showUpcoming),
-					tickCmd(),
-				)
+				var req request
+				m, req = m.beginRequest()
+				cmds = append(cmds, loadGamesCmd(m.provider, req, m.selectedSport.ID, m.selectedLeague.ID, m.gamesRange()))
 			}
 		}
-		return m, tickCmd()
+		return m, tea.Batch(cmds...)
 	}
 
 	return m, nil
@@ -266,15 +914,56 @@ func (m Model) View() string {
 		content = m.renderGamesView()
 	case gameDetailView:
 		content = m.renderGameDetailView()
+	case standingsView:
+		content = m.renderStandingsView()
+	case teamView:
+		content = m.renderTeamView()
+	case rosterView:
+		content = m.renderRosterView()
+	case playerView:
+		content = m.renderPlayerView()
+	case myTeamsView:
+		content = m.renderMyTeamsView()
+	case liveView:
+		content = m.renderLiveView()
+	case notificationsView:
+		content = m.renderNotificationsView()
 	}
 
-	return lipgloss.Place(
+	if m.stale != nil {
+		content = lipgloss.JoinVertical(lipgloss.Left, m.renderStaleBanner(), content)
+	}
+
+	// Transient prompts and notices sit below the active view
+	if m.starPrompt {
+		content = lipgloss.JoinVertical(lipgloss.Left, content,
+			selectedItemStyle.Render("★ Star which team? a away • h home • any other key cancels"))
+	} else if m.notice != "" {
+		content = lipgloss.JoinVertical(lipgloss.Left, content, subtitleStyle.Render(m.notice))
+	} else if m.toastVisible() && m.state != notificationsView {
+		content = lipgloss.JoinVertical(lipgloss.Left, content, m.renderToast())
+	}
+
+	view := lipgloss.Place(
 		m.width,
 		m.height,
 		lipgloss.Left,
 		lipgloss.Top,
 		content,
 	)
+	if m.bell {
+		view = "\a" + view
+	}
+	return view
+}
+
+// renderHelp renders a key help line, wrapping it to the terminal width so
+// long key lists stay readable on narrow terminals.
+func (m Model) renderHelp(text string) string {
+	if m.width > 0 {
+		return helpStyle.Width(m.width).Render(text)
+	}
+	return helpStyle.Render(text)
 }
 
 func (m Model) renderSportView() string {
@@ -282,7 +971,7 @@ func (m Model) renderSportView() string {
 	subtitle := subtitleStyle.Render("Select a sport")
 
 	var items string
-	for i, sport := range api.AvailableSports {
+	for i, sport := range m.sports {
 		cursor := "  "
 		style := itemStyle
 		if i == m.sportCursor {
@@ -293,7 +982,11 @@ func (m Model) renderSportView() string {
 		items += style.Render(fmt.Sprintf("%s%s %s", cursor, icon, sport.Name)) + "\n"
 	}
 
-	help := helpStyle.Render("↑/k up • ↓/j down • enter select • q quit")
+	keys := "↑/k up • ↓/j down • enter select • m my teams • L all live • "
+	if m.alerts != nil {
+		keys += "C close games • "
+	}
+	help := m.renderHelp(keys + "n notifications • q quit")
 
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
@@ -325,7 +1018,7 @@ func (m Model) renderLeagueView() string {
 		items += style.Render(fmt.Sprintf("%s%s", cursor, league.Name)) + "\n"
 	}
 
-	help := helpStyle.Render("↑/k up • ↓/j down • enter select • esc back • q quit")
+	help := m.renderHelp("↑/k up • ↓/j down • enter select • s standings • esc back • q quit")
 
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
@@ -343,39 +1036,45 @@ func (m Model) renderGamesView() string {
 		return "No league selected"
 	}
 
-	title := titleStyle.Render(fmt.Sprintf("🏆 %s - %s", m.selectedSport.Name, m.selectedLeague.Name))
+	dates := m.gamesRange()
+
+	title := titleStyle.Render(fmt.Sprintf("🏆 %s - %s • %s", m.selectedSport.Name, m.selectedLeague.Name, formatDay(m.selectedDate)))
 
 	var statusText string
 	if m.loading {
-		statusText = subtitleStyle.Render("Loading games...")
+		statusText = subtitleStyle.Render(fmt.Sprintf("Loading games for %s...", dates))
 	} else {
-		lastUpdate := m.lastUpdate.Format("3:04 PM")
-		statusText = subtitleStyle.Render(fmt.Sprintf("Last updated: %s", lastUpdate))
+		lastUpdate := m.lastUpdate.In(m.loc).Format(clockLayout)
+		statusText = subtitleStyle.Render(fmt.Sprintf("%s • Last updated: %s", dates, lastUpdate))
+	}
+
+	if m.datePicker {
+		return lipgloss.JoinVertical(lipgloss.Left, title, statusText, "", m.renderDatePicker())
 	}
 
 	if m.err != nil {
-		errorMsg := errorStyle.Render(fmt.Sprintf("Error: %v", m.err))
-		help := helpStyle.Render("r refresh • esc back • q quit")
+		errorMsg := m.renderError()
+		help := m.renderHelp("[/] prev/next day • t today • d date • s standings • r refresh • esc back • q quit")
 		return lipgloss.JoinVertical(lipgloss.Left, title, "", errorMsg, "", help)
 	}
 
 	if len(m.games) == 0 && !m.loading {
 		var noGamesText string
 		if !m.showUpcoming {
-			noGamesText = "No current games. Press 'u' to view upcoming games."
+			noGamesText = fmt.Sprintf("No games on %s. Press ']' for the next day or 'u' to view upcoming games.", dates)
 		} else {
-			noGamesText = "No upcoming games scheduled."
+			noGamesText = fmt.Sprintf("No games scheduled for %s.", dates)
 		}
 		noGames := itemStyle.Render(noGamesText)
 
 		// Build help text
-		helpText := "r refresh • esc back • q quit"
+		helpText := "[/] prev/next day • t today • d date • s standings • r refresh • esc back • q quit"
 		if !m.showUpcoming {
-			helpText = "u upcoming • r refresh • esc back • q quit"
+			helpText = "[/] prev/next day • t today • d date • u upcoming • s standings • r refresh • esc back • q quit"
 		} else {
-			helpText = "u current • r refresh • esc back • q quit"
+			helpText = "[/] prev/next day • t today • d date • u single day • s standings • r refresh • esc back • q quit"
 		}
-		help := helpStyle.Render(helpText)
+		help := m.renderHelp(helpText)
 
 		return lipgloss.JoinVertical(lipgloss.Left, title, statusText, "", noGames, "", help)
 	}
@@ -390,6 +1089,9 @@ func (m Model) renderGamesView() string {
 
 	var items string
 	for i := startIdx; i < endIdx; i++ {
+		if m.showsDayHeader(i, startIdx) {
+			items += dayHeaderStyle.Render(formatDay(m.games[i].Date.In(m.loc))) + "\n\n"
+		}
 		cursor := "  "
 		if i == m.gameCursor {
 			cursor = "❯ "
@@ -404,13 +1106,13 @@ func (m Model) renderGamesView() string {
 	}
 
 	// Build help text based on current state
-	helpText := "↑/k up • ↓/j down • enter details • r refresh • esc back • q quit"
+	helpText := "↑/k up • ↓/j down • enter details • a/h away/home team • f star team • x linescores • [/] prev/next day • t today • d date • s standings • r refresh • esc back • q quit"
 	if !m.showUpcoming {
-		helpText = "↑/k up • ↓/j down • enter details • u upcoming • r refresh • esc back • q quit"
+		helpText = "↑/k up • ↓/j down • enter details • a/h away/home team • f star team • x linescores • [/] prev/next day • t today • d date • u upcoming • s standings • r refresh • esc back • q quit"
 	} else {
-		helpText = "↑/k up • ↓/j down • enter details • u current • r refresh • esc back • q quit"
+		helpText = "↑/k up • ↓/j down • enter details • a/h away/home team • f star team • x linescores • [/] prev/next day • t today • d date • u single day • s standings • r refresh • esc back • q quit"
 	}
-	help := helpStyle.Render(helpText)
+	help := m.renderHelp(helpText)
 
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
@@ -422,8 +1124,31 @@ func (m Model) renderGamesView() string {
 	)
 }
 
-// calculateVisibleGames calculates how many game cards can fit in the viewport
+// calculateVisibleGames calculates how many game cards, starting at the
+// current scroll offset, fit in the viewport
 func (m Model) calculateVisibleGames() int {
+	// Reserve space for: title (3), status (1), empty (1), help (2), margins (4)
+	const reservedLines = 11
+
+	availableHeight := m.height - reservedLines
+	count := 0
+	for i := m.gameScrollOffset; i < len(m.games); i++ {
+		availableHeight -= m.gameLines(i, m.gameScrollOffset)
+		if availableHeight < 0 {
+			break
+		}
+		count++
+	}
+
+	if count == 0 {
+		return 1 // Always show at least 1 game
+	}
+	return count
+}
+
+// gameLines returns how many lines the card for games[i] takes when the
+// list is rendered from start, including its day header if it has one.
+func (m Model) gameLines(i, start int) int {
 	// Each game card takes roughly 11 lines (including spacing):
 	// - Status (1 line)
 	// - Empty line (1)
@@ -436,15 +1161,54 @@ func (m Model) calculateVisibleGames() int {
 	// - Spacing between cards (2)
 	const linesPerGame = 11
 
-	// Reserve space for: title (3), status (1), empty (1), help (2), margins (4)
-	const reservedLines = 11
+	// A day header is the date line plus a blank line
+	const linesPerDayHeader = 2
 
-	availableHeight := m.height - reservedLines
-	if availableHeight < linesPerGame {
-		return 1 // Always show at least 1 game
+	lines := linesPerGame
+	if ref, ok := m.selectedRef(); ok && len(m.gameAlerts(ref, m.games[i])) > 0 {
+		// The alert badge under the status
+		lines++
+	}
+	if m.showsDayHeader(i, start) {
+		lines += linesPerDayHeader
 	}
+	if m.showLineScores && (len(m.games[i].AwayTeam.LineScores) > 0 || len(m.games[i].HomeTeam.LineScores) > 0) {
+		lines += lineScoreLines
+	}
+	return lines
+}
 
-	return availableHeight / linesPerGame
+// showsDayHeader reports whether a day header is drawn above games[i] when
+// the list is rendered from start. Headers are only used when the view
+// spans several days.
+func (m Model) showsDayHeader(i, start int) bool {
+	if m.gamesRange().SingleDay() {
+		return false
+	}
+	if i == start {
+		return true
+	}
+	return !sameDay(m.games[i-1].Date.In(m.loc), m.games[i].Date.In(m.loc))
+}
+
+func sameDay(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
+
+// formatDay labels a day relative to today, in t's time zone, where that
+// reads better.
+func formatDay(t time.Time) string {
+	now := time.Now().In(t.Location())
+	label := t.Format("Monday, January 2")
+	switch {
+	case sameDay(t, now):
+		return "Today • " + label
+	case sameDay(t, now.AddDate(0, 0, 1)):
+		return "Tomorrow • " + label
+	case sameDay(t, now.AddDate(0, 0, -1)):
+		return "Yesterday • " + label
+	}
+	return label
 }
 
 func (m Model) renderGame(game api.Game, selected bool) string {
@@ -460,6 +1224,21 @@ func (m Model) renderGame(game api.Game, selected bool) string {
 		status = statusStyle.Render(status)
 	}
 
+	// Highlight cards that just changed
+	if m.flashing(game.ID) {
+		boxStyle = boxStyle.BorderForeground(accentColor)
+		status = selectedItemStyle.UnsetPadding().Render("⚡ ") + status
+	}
+
+	// Mark games matching an alert rule
+	var badge string
+	if ref, ok := m.selectedRef(); ok {
+		if rules := m.gameAlerts(ref, game); len(rules) > 0 {
+			boxStyle = boxStyle.BorderForeground(liveColor)
+			badge = alertBadge(rules)
+		}
+	}
+
 	awayScore := game.AwayTeam.Score
 	homeScore := game.HomeTeam.Score
 
@@ -470,19 +1249,30 @@ func (m Model) renderGame(game api.Game, selected bool) string {
 		homeScore = "-"
 	}
 
-	gameTime := game.Date.Local().Format("Mon Jan 2, 3:04 PM")
+	gameTime := game.Date.In(m.loc).Format("Mon Jan 2, " + clockLayout)
 
-	content := lipgloss.JoinVertical(
-		lipgloss.Left,
-		status,
+	lines := []string{status}
+	if badge != "" {
+		lines = append(lines, badge)
+	}
+	lines = append(lines,
 		"",
-		teamStyle.Render(fmt.Sprintf("%-30s %3s", game.AwayTeam.Name, awayScore)),
-		teamStyle.Render(fmt.Sprintf("%-30s %3s", game.HomeTeam.Name, homeScore)),
+		teamStyle.Render(fmt.Sprintf("%-30s %3s", m.favoriteMark(game.AwayTeam.ID, game.AwayTeam.Name), awayScore)),
+		teamStyle.Render(fmt.Sprintf("%-30s %3s", m.favoriteMark(game.HomeTeam.ID, game.HomeTeam.Name), homeScore)),
+	)
+	if m.showLineScores {
+		if grid := renderLineScore(m.selectedSport.ID, m.selectedLeague.ID, gameLineScoreRow(game.AwayTeam), gameLineScoreRow(game.HomeTeam)); grid != "" {
+			lines = append(lines, "", grid)
+		}
+	}
+	lines = append(lines,
 		"",
 		venueStyle.Render(fmt.Sprintf("📍 %s", game.Venue)),
 		venueStyle.Render(fmt.Sprintf("🕐 %s", gameTime)),
 	)
 
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+
 	return boxStyle.Render(content)
 }
 
@@ -496,8 +1286,8 @@ func (m Model) renderGameDetailView() string {
 	}
 
 	if m.err != nil {
-		errorMsg := errorStyle.Render(fmt.Sprintf("Error: %v", m.err))
-		help := helpStyle.Render("esc back • q quit")
+		errorMsg := m.renderError()
+		help := m.renderHelp("esc back • q quit")
 		return lipgloss.JoinVertical(lipgloss.Left, titleStyle.Render("🏆 Game Details"), "", errorMsg, "", help)
 	}
 
@@ -511,10 +1301,52 @@ func (m Model) renderGameDetailView() string {
 	header := m.renderGameDetailHeader(detail)
 
 	// SCROLLABLE CONTENT - Everything else
-	var contentLines []string
+	contentLines, _ := m.gameDetailContent(detail)
+
+	// Calculate visible content (account for fixed header)
+	availableHeight := m.detailAvailableHeight()
+	startLine := m.detailScrollOffset
+	endLine := startLine + availableHeight
+	if endLine > len(contentLines) {
+		endLine = len(contentLines)
+	}
+	if startLine >= len(contentLines) {
+		startLine = 0
+	}
+
+	visibleContent := ""
+	if len(contentLines) > 0 {
+		visibleContent = lipgloss.JoinVertical(lipgloss.Left, contentLines[startLine:endLine]...)
+	}
+
+	// Scroll indicator
+	scrollInfo := ""
+	if len(contentLines) > availableHeight {
+		scrollInfo = lipgloss.NewStyle().Foreground(dimColor).Render(
+			fmt.Sprintf(" (Scroll: %d/%d lines)", startLine+1, len(contentLines)))
+	}
+
+	title := titleStyle.Render("🏆 Game Details") + scrollInfo
+	help := m.renderHelp("↑/k up • ↓/j down • tab select player • enter player profile • a/h away/home team • f star team • esc back • q quit")
 
-	// Venue and attendance
-	if detail.Venue != "" || detail.Attendance != "" {
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		"",
+		header,
+		"",
+		visibleContent,
+		"",
+		help,
+	)
+}
+
+// gameDetailContent builds the scrollable part of the game detail view. It
+// also returns, for each entry of detailAthletes, the content line that
+// player is rendered on so the view can scroll to the highlighted player.
+func (m Model) gameDetailContent(detail *api.GameDetail) (contentLines []string, athleteLines []int) {
+	// Venue, attendance and betting line
+	if detail.Venue != "" || detail.Attendance != "" || detail.Odds != nil {
 		contentLines = append(contentLines, lipgloss.NewStyle().Bold(true).Foreground(accentColor).Render("📍 Game Info"))
 		contentLines = append(contentLines, "")
 		if detail.Venue != "" {
@@ -523,6 +1355,13 @@ func (m Model) renderGameDetailView() string {
 		if detail.Attendance != "" {
 			contentLines = append(contentLines, venueStyle.Render(fmt.Sprintf("  Attendance: %s", detail.Attendance)))
 		}
+		if detail.Odds != nil {
+			line := detail.Odds.Details
+			if detail.Odds.OverUnder > 0 {
+				line += fmt.Sprintf(" • O/U %g", detail.Odds.OverUnder)
+			}
+			contentLines = append(contentLines, venueStyle.Render(fmt.Sprintf("  Line: %s", line)))
+		}
 		contentLines = append(contentLines, "")
 	}
 
@@ -530,13 +1369,18 @@ func (m Model) renderGameDetailView() string {
 	if len(detail.Leaders) > 0 {
 		contentLines = append(contentLines, lipgloss.NewStyle().Bold(true).Foreground(accentColor).Render("⭐ Game Leaders"))
 		contentLines = append(contentLines, "")
-		for _, leader := range detail.Leaders {
+		for i, leader := range detail.Leaders {
 			leaderLine := fmt.Sprintf("  %s: %s (%s) - %s",
 				leader.Category,
 				leader.Athlete,
 				leader.Team,
 				leader.Value)
-			contentLines = append(contentLines, itemStyle.Render(leaderLine))
+			style := itemStyle
+			if i == m.detailAthleteCursor {
+				style = selectedItemStyle
+			}
+			athleteLines = append(athleteLines, len(contentLines))
+			contentLines = append(contentLines, style.Render(leaderLine))
 		}
 		contentLines = append(contentLines, "")
 	}
@@ -586,6 +1430,20 @@ func (m Model) renderGameDetailView() string {
 		contentLines = append(contentLines, "")
 	}
 
+	// Player box scores, away team first
+	athleteIndex := len(detail.Leaders)
+	for _, team := range []api.TeamDetail{detail.AwayTeam, detail.HomeTeam} {
+		if len(team.BoxScore) == 0 {
+			continue
+		}
+		lines, rows := m.renderBoxScore(team, m.detailAthleteCursor-athleteIndex)
+		for _, row := range rows {
+			athleteLines = append(athleteLines, len(contentLines)+row)
+		}
+		contentLines = append(contentLines, lines...)
+		athleteIndex += len(rows)
+	}
+
 	// Recent Plays
 	if len(detail.Plays) > 0 {
 		contentLines = append(contentLines, lipgloss.NewStyle().Bold(true).Foreground(accentColor).Render("📝 Recent Plays"))
@@ -615,43 +1473,49 @@ func (m Model) renderGameDetailView() string {
 		}
 	}
 
-	// Calculate visible content (account for fixed header)
-	headerHeight := 8                              // Estimated height of fixed header
-	availableHeight := m.height - headerHeight - 4 // Reserve for help and margins
-	startLine := m.detailScrollOffset
-	endLine := startLine + availableHeight
-	if endLine > len(contentLines) {
-		endLine = len(contentLines)
-	}
-	if startLine >= len(contentLines) {
-		startLine = 0
+	// Injury report
+	if len(detail.Injuries) > 0 {
+		if len(detail.Plays) > 0 {
+			contentLines = append(contentLines, "")
+		}
+		contentLines = append(contentLines, lipgloss.NewStyle().Bold(true).Foreground(accentColor).Render("🩹 Injuries"))
+		contentLines = append(contentLines, "")
+		for _, injury := range detail.Injuries {
+			name := injury.Athlete
+			if injury.Position != "" {
+				name += " " + injury.Position
+			}
+			line := fmt.Sprintf("  %s (%s) - %s", name, injury.Team, injury.Status)
+			if injury.Detail != "" {
+				line += ", " + injury.Detail
+			}
+			contentLines = append(contentLines, statusStyle.Render(line))
+		}
 	}
 
-	visibleContent := ""
-	if len(contentLines) > 0 {
-		visibleContent = lipgloss.JoinVertical(lipgloss.Left, contentLines[startLine:endLine]...)
-	}
+	return contentLines, athleteLines
+}
 
-	// Scroll indicator
-	scrollInfo := ""
-	if len(contentLines) > availableHeight {
-		scrollInfo = lipgloss.NewStyle().Foreground(dimColor).Render(
-			fmt.Sprintf(" (Scroll: %d/%d lines)", startLine+1, len(contentLines)))
+// detailAvailableHeight returns how many content lines fit below the fixed
+// game detail header.
+func (m Model) detailAvailableHeight() int {
+	headerHeight := 8 // Estimated height of fixed header
+	if m.selectedGameDetail != nil && m.detailLineScore(m.selectedGameDetail) != "" {
+		headerHeight += lineScoreLines
 	}
+	if len(m.detailAlerts(m.selectedGameDetail)) > 0 {
+		// The alert badge under the status
+		headerHeight++
+	}
+	return m.height - headerHeight - 4 // Reserve for help and margins
+}
 
-	title := titleStyle.Render("🏆 Game Details") + scrollInfo
-	help := helpStyle.Render("↑/k up • ↓/j down • esc back • q quit")
-
-	return lipgloss.JoinVertical(
-		lipgloss.Left,
-		title,
-		"",
-		header,
-		"",
-		visibleContent,
-		"",
-		help,
-	)
+// detailLineScore renders the linescore grid for the detail header.
+func (m Model) detailLineScore(detail *api.GameDetail) string {
+	if m.selectedSport == nil || m.selectedLeague == nil {
+		return ""
+	}
+	return renderLineScore(m.selectedSport.ID, m.selectedLeague.ID, detailLineScoreRow(detail.AwayTeam), detailLineScoreRow(detail.HomeTeam))
 }
 
 func (m Model) renderGameDetailHeader(detail *api.GameDetail) string {
@@ -687,13 +1551,20 @@ func (m Model) renderGameDetailHeader(detail *api.GameDetail) string {
 		Padding(1, 2).
 		Width(m.width - 8)
 
-	scoreContent := lipgloss.JoinVertical(
-		lipgloss.Left,
-		status,
+	lines := []string{status}
+	if badge := alertBadge(m.detailAlerts(detail)); badge != "" {
+		lines = append(lines, badge)
+	}
+	lines = append(lines,
 		"",
-		teamStyle.Render(fmt.Sprintf("%s %-32s %5s", awayLogo, detail.AwayTeam.Name+awayRecord, detail.AwayTeam.Score)),
-		teamStyle.Render(fmt.Sprintf("%s %-32s %5s", homeLogo, detail.HomeTeam.Name+homeRecord, detail.HomeTeam.Score)),
+		teamStyle.Render(fmt.Sprintf("%s %-32s %5s", awayLogo, m.favoriteMark(detail.AwayTeam.ID, detail.AwayTeam.Name)+awayRecord, detail.AwayTeam.Score)),
+		teamStyle.Render(fmt.Sprintf("%s %-32s %5s", homeLogo, m.favoriteMark(detail.HomeTeam.ID, detail.HomeTeam.Name)+homeRecord, detail.HomeTeam.Score)),
 	)
+	if grid := m.detailLineScore(detail); grid != "" {
+		lines = append(lines, "", grid)
+	}
+
+	scoreContent := lipgloss.JoinVertical(lipgloss.Left, lines...)
 
 	return scoreBox.Render(scoreContent)
 }
@@ -712,64 +1583,25 @@ func getSportIcon(sportID string) string {
 	return "🏃"
 }
 
+// The palette and styles are set by setTheme; see theme.go.
 var (
-	primaryColor = lipgloss.Color("#7C3AED")
-	accentColor  = lipgloss.Color("#F59E0B")
-	liveColor    = lipgloss.Color("#EF4444")
-	textColor    = lipgloss.Color("#E5E7EB")
-	dimColor     = lipgloss.Color("#9CA3AF")
-	bgColor      = lipgloss.Color("#1F2937")
-
-	titleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(primaryColor).
-			Padding(1, 2).
-			MarginBottom(1)
-
-	subtitleStyle = lipgloss.NewStyle().
-			Foreground(dimColor).
-			Padding(0, 2)
-
-	itemStyle = lipgloss.NewStyle().
-			Foreground(textColor).
-			Padding(0, 2)
-
-	selectedItemStyle = lipgloss.NewStyle().
-				Bold(true).
-				Foreground(accentColor).
-				Padding(0, 2)
-
-	helpStyle = lipgloss.NewStyle().
-			Foreground(dimColor).
-			Padding(1, 2)
-
-	gameBoxStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(dimColor).
-			Padding(1, 2).
-			Width(60)
-
-	selectedGameBoxStyle = lipgloss.NewStyle().
-				Border(lipgloss.RoundedBorder()).
-				BorderForeground(primaryColor).
-				Padding(1, 2).
-				Width(60)
-
-	teamStyle = lipgloss.NewStyle().
-			Foreground(textColor).
-			Bold(true)
-
-	statusStyle = lipgloss.NewStyle().
-			Foreground(dimColor)
-
-	liveStyle = lipgloss.NewStyle().
-			Foreground(liveColor).
-			Bold(true)
-
-	venueStyle = lipgloss.NewStyle().
-			Foreground(dimColor)
-
-	errorStyle = lipgloss.NewStyle().
-			Foreground(liveColor).
-			Padding(0, 2)
+	primaryColor lipgloss.TerminalColor
+	accentColor  lipgloss.TerminalColor
+	liveColor    lipgloss.TerminalColor
+	textColor    lipgloss.TerminalColor
+	dimColor     lipgloss.TerminalColor
+
+	titleStyle           lipgloss.Style
+	subtitleStyle        lipgloss.Style
+	itemStyle            lipgloss.Style
+	selectedItemStyle    lipgloss.Style
+	helpStyle            lipgloss.Style
+	gameBoxStyle         lipgloss.Style
+	selectedGameBoxStyle lipgloss.Style
+	teamStyle            lipgloss.Style
+	statusStyle          lipgloss.Style
+	liveStyle            lipgloss.Style
+	venueStyle           lipgloss.Style
+	dayHeaderStyle       lipgloss.Style
+	errorStyle           lipgloss.Style
 )
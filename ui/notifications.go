@@ -0,0 +1,356 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/elliota43/sportsterminal/api"
+	"github.com/elliota43/sportsterminal/notify"
+	"github.com/elliota43/sportsterminal/watch"
+)
+
+const (
+	// flashDuration is how long a changed game card stays highlighted.
+	flashDuration = 10 * time.Second
+
+	// toastDuration is how long a notification stays in the status bar.
+	toastDuration = 8 * time.Second
+
+	// bellDuration is how long the bell stays in the view, long enough
+	// for a frame to draw it.
+	bellDuration = 100 * time.Millisecond
+
+	// maxNotifications bounds the notification history.
+	maxNotifications = 100
+)
+
+// notification is a score change or an alert shown as a toast and kept in
+// the history.
+type notification struct {
+	at     time.Time
+	league string
+	event  watch.Event
+
+	// alert names the rule a game started matching. event then only
+	// carries the game.
+	alert string
+}
+
+// key identifies a notification so the same change seen through two
+// refreshes (the games view and followed teams) is reported once.
+func (n notification) key() string {
+	if n.alert != "" {
+		return "alert/" + alertKey(n.alert, n.event.Game.ID)
+	}
+	return fmt.Sprintf("%s/%s/%s", n.event.Type, n.event.Game.ID, n.event.Scoreline())
+}
+
+// text describes the notification in a sentence.
+func (n notification) text() string {
+	if n.alert == "" {
+		return n.event.String()
+	}
+	status := n.event.Game.StatusDetail
+	if status == "" {
+		status = n.event.Game.Status
+	}
+	return fmt.Sprintf("%s: %s • %s", n.alert, n.event.Scoreline(), status)
+}
+
+func (n notification) icon() string {
+	if n.alert != "" {
+		return "🚨"
+	}
+	return "🔔"
+}
+
+// followedLoadedMsg carries the scoreboards of the leagues with favorite
+// teams, refreshed in the background on every tick.
+type followedLoadedMsg struct {
+	results []api.LeagueGames
+}
+
+type desktopNotifiedMsg struct {
+	err error
+}
+
+type flashExpiredMsg struct{}
+
+type toastExpiredMsg struct{}
+
+type bellRungMsg struct{}
+
+func loadFollowedCmd(provider api.Provider, leagues []api.LeagueRef, loc *time.Location) tea.Cmd {
+	return func() tea.Msg {
+		return followedLoadedMsg{results: api.FetchScoreboards(context.Background(), provider, leagues, api.Day(today(loc)))}
+	}
+}
+
+func flashExpiryCmd() tea.Cmd {
+	return tea.Tick(flashDuration, func(time.Time) tea.Msg { return flashExpiredMsg{} })
+}
+
+func toastExpiryCmd() tea.Cmd {
+	return tea.Tick(toastDuration, func(time.Time) tea.Msg { return toastExpiredMsg{} })
+}
+
+func desktopNotifyCmd(notifier notify.Notifier, n notify.Notification) tea.Cmd {
+	return func() tea.Msg {
+		return desktopNotifiedMsg{err: notifier.Notify(n)}
+	}
+}
+
+// followedLeagues returns the leagues refreshed in the background: those
+// with favorite teams, plus those named by desktop notification rules.
+func (m Model) followedLeagues() []api.LeagueRef {
+	leagues := favoriteLeagues(m.config.Favorites)
+	if m.notifier == nil {
+		return leagues
+	}
+	seen := map[api.LeagueRef]bool{}
+	for _, ref := range leagues {
+		seen[ref] = true
+	}
+	for _, rule := range m.config.Notifications.Rules {
+		ref := api.LeagueRef{Sport: rule.Sport, League: rule.League}
+		if !seen[ref] && len(rule.Events) > 0 {
+			seen[ref] = true
+			leagues = append(leagues, ref)
+		}
+	}
+	return leagues
+}
+
+func bellRungCmd() tea.Cmd {
+	return tea.Tick(bellDuration, func(time.Time) tea.Msg { return bellRungMsg{} })
+}
+
+// involvesFavorite reports whether either team in a game is starred.
+func (m Model) involvesFavorite(ref api.LeagueRef, game api.Game) bool {
+	return m.config.IsFavorite(ref.Sport, ref.League, game.HomeTeam.ID) ||
+		m.config.IsFavorite(ref.Sport, ref.League, game.AwayTeam.ID)
+}
+
+// updateFollowed diffs the background scoreboards of followed leagues,
+// notifies about changes to favorite teams' games and sends the desktop
+// notifications the config asks for.
+func (m Model) updateFollowed(msg followedLoadedMsg) (Model, tea.Cmd) {
+	if m.followed == nil {
+		m.followed = map[api.LeagueRef][]api.Game{}
+		m.followedLeaders = watch.Leaders{}
+	}
+
+	var events []notification
+	var cmds []tea.Cmd
+	for _, result := range msg.results {
+		if result.Failed() {
+			continue
+		}
+		ref := result.LeagueRef
+		if prev, ok := m.followed[ref]; ok {
+			for _, e := range watch.Diff(prev, result.Games, m.followedLeaders) {
+				league := m.leagueName(ref.Sport, ref.League)
+				if m.involvesFavorite(ref, e.Game) {
+					events = append(events, notification{at: time.Now(), league: league, event: e})
+				}
+				favorite := func(teamID string) bool { return m.config.IsFavorite(ref.Sport, ref.League, teamID) }
+				if m.notifier != nil && notify.Wanted(m.config.Notifications, ref.Sport, ref.League, e, favorite) {
+					cmds = append(cmds, desktopNotifyCmd(m.notifier, notify.ForEvent(league, e)))
+				}
+			}
+		}
+		m.followed[ref] = result.Games
+	}
+
+	m, cmd := m.notify(events)
+	return m, tea.Batch(append(cmds, cmd)...)
+}
+
+// desktopNotified reports the first failed desktop notification.
+func (m Model) desktopNotified(msg desktopNotifiedMsg) Model {
+	if msg.err != nil && !m.desktopErrShown {
+		m.desktopErrShown = true
+		m.notice = fmt.Sprintf("Desktop notifications failed: %v", msg.err)
+	}
+	return m
+}
+
+// diffGames compares a refreshed games list with the one on screen,
+// flashing changed cards and notifying about each change.
+func (m Model) diffGames(games []api.Game) (Model, tea.Cmd) {
+	if m.selectedSport == nil || m.selectedLeague == nil {
+		return m, nil
+	}
+
+	if m.leaders == nil {
+		m.leaders = watch.Leaders{}
+	}
+	var events []notification
+	var flash bool
+	for _, e := range watch.Diff(m.games, games, m.leaders) {
+		if m.flash == nil {
+			m.flash = map[string]time.Time{}
+		}
+		m.flash[e.Game.ID] = time.Now().Add(flashDuration)
+		flash = true
+		events = append(events, notification{at: time.Now(), league: m.selectedLeague.Name, event: e})
+	}
+
+	// Forget the leaders of games no longer listed, such as those of
+	// another league or day
+	listed := map[string]bool{}
+	for _, game := range games {
+		listed[game.ID] = true
+	}
+	for id := range m.leaders {
+		if !listed[id] {
+			delete(m.leaders, id)
+		}
+	}
+
+	m, cmd := m.notify(events)
+	if flash {
+		cmd = tea.Batch(cmd, flashExpiryCmd())
+	}
+	return m, cmd
+}
+
+// notify records new notifications, toasts the latest and rings the bell
+// when enabled.
+func (m Model) notify(events []notification) (Model, tea.Cmd) {
+	seen := map[string]bool{}
+	for _, n := range m.notifications {
+		seen[n.key()] = true
+	}
+
+	var fresh []notification
+	for _, n := range events {
+		if !seen[n.key()] {
+			seen[n.key()] = true
+			fresh = append(fresh, n)
+		}
+	}
+	if len(fresh) == 0 {
+		return m, nil
+	}
+
+	// Newest first
+	for _, n := range fresh {
+		m.notifications = append([]notification{n}, m.notifications...)
+	}
+	if len(m.notifications) > maxNotifications {
+		m.notifications = m.notifications[:maxNotifications]
+	}
+
+	m.toast = m.notifications[0]
+	m.toastUntil = time.Now().Add(toastDuration)
+	if len(fresh) > 1 {
+		m.toastMore = len(fresh) - 1
+	} else {
+		m.toastMore = 0
+	}
+
+	cmds := []tea.Cmd{toastExpiryCmd()}
+	if m.config.Bell {
+		// The view rings the bell, as the program owns the terminal
+		m.bell = true
+		cmds = append(cmds, bellRungCmd())
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// flashing reports whether a game card is highlighted after a change.
+func (m Model) flashing(gameID string) bool {
+	until, ok := m.flash[gameID]
+	return ok && time.Now().Before(until)
+}
+
+// expireFlashes forgets highlights that have run their course.
+func (m Model) expireFlashes() Model {
+	if len(m.flash) == 0 {
+		return m
+	}
+	flash := map[string]time.Time{}
+	for id, until := range m.flash {
+		if time.Now().Before(until) {
+			flash[id] = until
+		}
+	}
+	m.flash = flash
+	return m
+}
+
+// toastVisible reports whether the status bar shows a notification.
+func (m Model) toastVisible() bool {
+	return m.toastUntil.After(time.Now())
+}
+
+func (m Model) renderToast() string {
+	text := fmt.Sprintf("%s %s • %s", m.toast.icon(), m.toast.league, m.toast.text())
+	if m.toastMore > 0 {
+		text += fmt.Sprintf(" (+%d more, n for history)", m.toastMore)
+	}
+	return liveStyle.Padding(0, 2).Render(text)
+}
+
+// openNotifications shows the notification history.
+func (m Model) openNotifications() Model {
+	if m.state != notificationsView {
+		m.notificationsReturn = m.state
+	}
+	m.state = notificationsView
+	m.notificationsScrollOffset = 0
+	m.toastUntil = time.Time{}
+	return m
+}
+
+func (m Model) renderNotificationsView() string {
+	title := titleStyle.Render("🏆 🔔 Notifications")
+
+	if len(m.notifications) == 0 {
+		empty := itemStyle.Render("No score changes yet. Changes to games on screen and to your starred teams, and alerts, show up here.")
+		help := m.renderHelp("esc back • q quit")
+		return lipgloss.JoinVertical(lipgloss.Left, title, "", empty, "", help)
+	}
+
+	var lines []string
+	for _, n := range m.notifications {
+		style := itemStyle
+		switch {
+		case n.alert != "":
+			style = itemStyle.Foreground(liveColor)
+		case n.event.Type == watch.ScoreChanged:
+			style = itemStyle.Foreground(accentColor)
+		}
+		lines = append(lines, statusStyle.Render("  "+n.at.In(m.loc).Format(clockLayout)+"  ")+
+			style.UnsetPadding().Render(fmt.Sprintf("%s • %s", n.league, n.text())))
+	}
+
+	available := m.height - 9
+	if available < 5 {
+		available = 5
+	}
+	start := m.notificationsScrollOffset
+	if start > len(lines)-1 {
+		start = len(lines) - 1
+	}
+	end := start + available
+	if end > len(lines) {
+		end = len(lines)
+	}
+
+	subtitle := subtitleStyle.Render(fmt.Sprintf("%d notifications, newest first", len(m.notifications)))
+	help := m.renderHelp("↑/k up • ↓/j down • c clear • esc back • q quit")
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		subtitle,
+		"",
+		lipgloss.JoinVertical(lipgloss.Left, lines[start:end]...),
+		help,
+	)
+}
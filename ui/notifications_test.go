@@ -0,0 +1,224 @@
+package ui
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elliota43/sportsterminal/api"
+	"github.com/elliota43/sportsterminal/config"
+	"github.com/elliota43/sportsterminal/notify"
+)
+
+// fakeProvider lists the usual leagues. Calls to the Provider methods it
+// doesn't implement panic.
+type fakeProvider struct {
+	api.Provider
+}
+
+func (fakeProvider) Leagues() []api.Sport {
+	return api.AvailableSports
+}
+
+var nba = api.LeagueRef{Sport: "basketball", League: "nba"}
+
+// followedGame returns a snapshot of the Knicks at the Celtics.
+func followedGame(state string, away, home string) api.Game {
+	status := map[string]string{"pre": "Scheduled", "in": "In Progress", "post": "Final"}[state]
+	return api.Game{
+		ID:        "1",
+		Status:    status,
+		State:     state,
+		IsLive:    state == "in",
+		Completed: state == "post",
+		AwayTeam:  api.Team{ID: "18", ShortName: "Knicks", Score: away},
+		HomeTeam:  api.Team{ID: "2", ShortName: "Celtics", Score: home},
+	}
+}
+
+// followedGameSnapshots take the game from before tip-off to the final,
+// with the lead changing hands twice.
+var followedGameSnapshots = []api.Game{
+	followedGame("pre", "", ""),
+	followedGame("in", "0", "0"),
+	followedGame("in", "10", "8"),
+	followedGame("in", "10", "12"),
+	followedGame("post", "10", "14"),
+}
+
+// runCmd runs cmd and the commands it batches, returning the messages
+// they produce within a short wait. Timers, such as the toast's, are left
+// running.
+func runCmd(cmd tea.Cmd) []tea.Msg {
+	msgs := make(chan tea.Msg, 100)
+	var run func(cmd tea.Cmd)
+	run = func(cmd tea.Cmd) {
+		if cmd == nil {
+			return
+		}
+		go func() {
+			msg := cmd()
+			if batch, ok := msg.(tea.BatchMsg); ok {
+				for _, cmd := range batch {
+					run(cmd)
+				}
+				return
+			}
+			msgs <- msg
+		}()
+	}
+	run(cmd)
+
+	var out []tea.Msg
+	timeout := time.After(50 * time.Millisecond)
+	for {
+		select {
+		case msg := <-msgs:
+			out = append(out, msg)
+		case <-timeout:
+			return out
+		}
+	}
+}
+
+// followGame feeds the snapshots to the model as background refreshes of
+// the NBA and hands it the outcome of each desktop notification.
+func followGame(m Model, snapshots []api.Game) Model {
+	for _, game := range snapshots {
+		msg := followedLoadedMsg{results: []api.LeagueGames{{LeagueRef: nba, Games: []api.Game{game}}}}
+		var cmd tea.Cmd
+		m, cmd = m.updateFollowed(msg)
+		for _, msg := range runCmd(cmd) {
+			if notified, ok := msg.(desktopNotifiedMsg); ok {
+				m = m.desktopNotified(notified)
+			}
+		}
+	}
+	return m
+}
+
+// sentTitles returns the titles of the notifications sent, sorted, as
+// those from one refresh are sent concurrently.
+func sentTitles(r *notify.Recorder) []string {
+	var titles []string
+	for _, n := range r.Sent() {
+		titles = append(titles, n.Title)
+	}
+	sort.Strings(titles)
+	return titles
+}
+
+func TestFollowedGameNotifications(t *testing.T) {
+	celtics := []config.Favorite{{Sport: "basketball", League: "nba", TeamID: "2", Name: "Boston Celtics"}}
+	tests := []struct {
+		name      string
+		favorites []config.Favorite
+		settings  config.Notifications
+		want      []string
+	}{
+		{
+			"favorite defaults",
+			celtics,
+			config.Notifications{},
+			[]string{"NBA • Final", "NBA • Game started", "NBA • Lead change", "NBA • Lead change"},
+		},
+		{
+			"favorite events",
+			celtics,
+			config.Notifications{Events: []string{"game_final"}},
+			[]string{"NBA • Final"},
+		},
+		{
+			"team rule",
+			celtics,
+			config.Notifications{Rules: []config.NotifyRule{
+				{Sport: "basketball", League: "nba", TeamID: "2", Events: []string{"game_started", "game_final"}},
+			}},
+			[]string{"NBA • Final", "NBA • Game started"},
+		},
+		{
+			"league rule without favorites",
+			nil,
+			config.Notifications{Rules: []config.NotifyRule{
+				{Sport: "basketball", League: "nba", Events: []string{"lead_changed"}},
+			}},
+			[]string{"NBA • Lead change", "NBA • Lead change"},
+		},
+		{
+			"muted favorite",
+			celtics,
+			config.Notifications{Rules: []config.NotifyRule{
+				{Sport: "basketball", League: "nba", TeamID: "2", Events: []string{}},
+			}},
+			nil,
+		},
+		{
+			"rule for another league",
+			nil,
+			config.Notifications{Rules: []config.NotifyRule{
+				{Sport: "hockey", League: "nhl", Events: []string{"game_final"}},
+			}},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		tt.settings.Desktop = true
+		recorder := &notify.Recorder{}
+		m := NewModel(fakeProvider{}, Options{
+			Config:   config.Config{Favorites: tt.favorites, Notifications: tt.settings},
+			Notifier: recorder,
+		})
+
+		m = followGame(m, followedGameSnapshots)
+		if got := sentTitles(recorder); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: sent %q, want %q", tt.name, got, tt.want)
+		}
+		if m.notice != "" {
+			t.Errorf("%s: notice = %q, want none", tt.name, m.notice)
+		}
+	}
+}
+
+func TestFollowedGameNotificationBody(t *testing.T) {
+	recorder := &notify.Recorder{}
+	m := NewModel(fakeProvider{}, Options{
+		Config: config.Config{
+			Favorites:     []config.Favorite{{Sport: "basketball", League: "nba", TeamID: "2"}},
+			Notifications: config.Notifications{Desktop: true, Events: []string{"game_final"}},
+		},
+		Notifier: recorder,
+	})
+
+	followGame(m, followedGameSnapshots)
+	want := []notify.Notification{{Title: "NBA • Final", Body: "Final: Knicks 10 - 14 Celtics"}}
+	if got := recorder.Sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %+v, want %+v", got, want)
+	}
+}
+
+func TestDesktopNotificationFailure(t *testing.T) {
+	recorder := &notify.Recorder{Err: errors.New("no notification daemon")}
+	m := NewModel(fakeProvider{}, Options{
+		Config: config.Config{
+			Favorites:     []config.Favorite{{Sport: "basketball", League: "nba", TeamID: "2"}},
+			Notifications: config.Notifications{Desktop: true},
+		},
+		Notifier: recorder,
+	})
+
+	m = followGame(m, followedGameSnapshots[:2])
+	if !m.desktopErrShown || !strings.Contains(m.notice, "Desktop notifications failed: no notification daemon") {
+		t.Fatalf("after a failed notification: notice = %q, shown = %v", m.notice, m.desktopErrShown)
+	}
+
+	// Later failures don't bring the notice back once dismissed
+	m.notice = ""
+	m = followGame(m, followedGameSnapshots[2:])
+	if m.notice != "" {
+		t.Errorf("notice after more failures = %q, want none", m.notice)
+	}
+}
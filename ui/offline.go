@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/elliota43/sportsterminal/api"
+)
+
+// loaded records whether a load was served stale from the cache and
+// returns the error to show. Stale results are shown under the offline
+// banner rather than as an error, so it returns nil for them.
+func (m Model) loaded(err error) (Model, error) {
+	var stale *api.StaleError
+	if errors.As(err, &stale) {
+		m.stale = stale
+		return m, nil
+	}
+	if err == nil {
+		m.stale = nil
+	}
+	return m, err
+}
+
+// updatedAt returns when the data on screen was fetched: now, unless it
+// came stale from the cache.
+func (m Model) updatedAt() time.Time {
+	if m.stale != nil {
+		return m.stale.Since
+	}
+	return time.Now()
+}
+
+// loadedResults is loaded for a set of scoreboards, which count as stale
+// from the oldest stale one.
+func (m Model) loadedResults(results []api.LeagueGames) Model {
+	m.stale = nil
+	for _, result := range results {
+		var stale *api.StaleError
+		if errors.As(result.Err, &stale) && (m.stale == nil || stale.Since.Before(m.stale.Since)) {
+			m.stale = stale
+		}
+	}
+	return m
+}
+
+// renderStaleBanner explains that the screen shows old data.
+func (m Model) renderStaleBanner() string {
+	since := m.stale.Since.In(m.loc)
+	layout := clockLayout
+	if !sameDay(since, today(m.loc)) {
+		layout = "Mon Jan 2 " + clockLayout
+	}
+
+	text := fmt.Sprintf("⚠ Offline • stale since %s", since.Format(layout))
+	if errors.Is(m.stale.Err, api.ErrOffline) {
+		text = fmt.Sprintf("⚠ Offline mode • saved %s", since.Format(layout))
+	}
+	return lipgloss.NewStyle().Foreground(liveColor).Bold(true).Padding(0, 2).Render(text)
+}
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"context"
+	"time"
+)
+
+// request is a foreground load: one fetching the data of the screen on
+// display. Only one is in flight at a time. Leaving the screen or starting
+// another load cancels it, and its loaded message, which carries the id,
+// is dropped on arrival so it can't overwrite the screen that replaced it.
+//
+// Background loads, such as followed games and the league check, aren't
+// requests: they outlive any one screen.
+type request struct {
+	id  uint64
+	ctx context.Context
+}
+
+// beginRequest cancels the foreground load, if any, and starts a new one.
+// Loading flags and any pending retry are cleared, so callers set their
+// own afterwards.
+func (m Model) beginRequest() (Model, request) {
+	m = m.abandonRequest()
+	ctx, cancel := context.WithCancel(context.Background())
+	m.req = request{id: m.req.id, ctx: ctx}
+	m.cancelReq = cancel
+	return m, m.req
+}
+
+// abandonRequest cancels the foreground load, if any, so its response is
+// dropped. Nothing is loading or waiting to be retried afterwards, and the
+// offline banner, which describes the abandoned load, is cleared.
+func (m Model) abandonRequest() Model {
+	if m.cancelReq != nil {
+		m.cancelReq()
+		m.cancelReq = nil
+	}
+	m.req = request{id: m.req.id + 1}
+	m.retryAt = time.Time{}
+	m.retries = 0
+	m.stale = nil
+
+	m.loading = false
+	m.loadingDetail = false
+	m.loadingStandings = false
+	m.loadingTeam = false
+	m.loadingRoster = false
+	m.loadingPlayer = false
+	m.loadingMyTeams = false
+	m.loadingLive = false
+	return m
+}
+
+// current reports whether a loaded message with id answers the foreground
+// load, as opposed to one cancelled since.
+func (m Model) current(id uint64) bool {
+	return id == m.req.id
+}
@@ -0,0 +1,267 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/elliota43/sportsterminal/api"
+)
+
+type rosterLoadedMsg struct {
+	id     uint64
+	roster []api.Athlete
+	err    error
+}
+
+type playerLoadedMsg struct {
+	id     uint64
+	player *api.AthleteProfile
+	err    error
+}
+
+func loadRosterCmd(provider api.Provider, req request, sport, league, teamID string) tea.Cmd {
+	return func() tea.Msg {
+		roster, err := provider.Roster(req.ctx, sport, league, teamID)
+		return rosterLoadedMsg{id: req.id, roster: roster, err: err}
+	}
+}
+
+func loadPlayerCmd(provider api.Provider, req request, sport, league, athleteID string) tea.Cmd {
+	return func() tea.Msg {
+		player, err := provider.Athlete(req.ctx, sport, league, athleteID)
+		return playerLoadedMsg{id: req.id, player: player, err: err}
+	}
+}
+
+// openRoster switches from the team page to the team's roster.
+func (m Model) openRoster() (Model, tea.Cmd) {
+	if m.team == nil || m.selectedSport == nil || m.selectedLeague == nil {
+		return m, nil
+	}
+	m, req := m.beginRequest()
+	m.state = rosterView
+	m.roster = nil
+	m.loadingRoster = true
+	m.rosterCursor = 0
+	m.rosterScrollOffset = 0
+	m.err = nil
+	return m, loadRosterCmd(m.provider, req, m.selectedSport.ID, m.selectedLeague.ID, m.team.ID)
+}
+
+// openPlayer switches to a player's profile, remembering the view to
+// return to.
+func (m Model) openPlayer(athleteID string) (Model, tea.Cmd) {
+	if athleteID == "" || m.selectedSport == nil || m.selectedLeague == nil {
+		return m, nil
+	}
+	m, req := m.beginRequest()
+	m.playerReturn = m.state
+	m.state = playerView
+	m.player = nil
+	m.playerID = athleteID
+	m.loadingPlayer = true
+	m.playerScrollOffset = 0
+	m.err = nil
+	return m, loadPlayerCmd(m.provider, req, m.selectedSport.ID, m.selectedLeague.ID, athleteID)
+}
+
+// detailAthletes returns the IDs of the players that can be highlighted in
+// the game detail view, in display order. The detail view's athlete cursor
+// indexes into this list.
+func (m Model) detailAthletes() []string {
+	if m.selectedGameDetail == nil {
+		return nil
+	}
+	detail := m.selectedGameDetail
+	var ids []string
+	for _, leader := range detail.Leaders {
+		ids = append(ids, leader.AthleteID)
+	}
+	for _, team := range []api.TeamDetail{detail.AwayTeam, detail.HomeTeam} {
+		for _, group := range team.BoxScore {
+			for _, p := range group.Players {
+				ids = append(ids, p.AthleteID)
+			}
+		}
+	}
+	return ids
+}
+
+// scrollToDetailAthlete adjusts the detail scroll offset so the
+// highlighted player is on screen.
+func (m Model) scrollToDetailAthlete() Model {
+	if m.selectedGameDetail == nil {
+		return m
+	}
+	_, athleteLines := m.gameDetailContent(m.selectedGameDetail)
+	if m.detailAthleteCursor < 0 || m.detailAthleteCursor >= len(athleteLines) {
+		return m
+	}
+
+	line := athleteLines[m.detailAthleteCursor]
+	available := m.detailAvailableHeight()
+	if line < m.detailScrollOffset {
+		m.detailScrollOffset = line
+	} else if available > 0 && line >= m.detailScrollOffset+available {
+		m.detailScrollOffset = line - available + 1
+	}
+	return m
+}
+
+// visibleRosterRows returns how many roster rows fit in the viewport.
+func (m Model) visibleRosterRows() int {
+	rows := m.height - 10 // Reserve for title, header, help and margins
+	if rows < 1 {
+		return 1
+	}
+	return rows
+}
+
+func (m Model) renderRosterView() string {
+	teamName := "Roster"
+	if m.team != nil {
+		teamName = m.team.Name + " Roster"
+	}
+	title := titleStyle.Render("🏆 " + teamName)
+
+	if m.loadingRoster {
+		return lipgloss.JoinVertical(lipgloss.Left, title, subtitleStyle.Render("Loading roster..."))
+	}
+
+	if m.err != nil {
+		errorMsg := m.renderError()
+		help := m.renderHelp("esc back • q quit")
+		return lipgloss.JoinVertical(lipgloss.Left, title, "", errorMsg, "", help)
+	}
+
+	if len(m.roster) == 0 {
+		noPlayers := itemStyle.Render("No roster available for this team.")
+		help := m.renderHelp("esc back • q quit")
+		return lipgloss.JoinVertical(lipgloss.Left, title, "", noPlayers, "", help)
+	}
+
+	header := lipgloss.NewStyle().Bold(true).Foreground(primaryColor).Render(
+		fmt.Sprintf("  %4s  %-4s %-26s %-8s %-9s %4s", "#", "POS", "Name", "HT", "WT", "AGE"))
+
+	startIdx := m.rosterScrollOffset
+	endIdx := startIdx + m.visibleRosterRows()
+	if endIdx > len(m.roster) {
+		endIdx = len(m.roster)
+	}
+
+	var rows []string
+	for i := startIdx; i < endIdx; i++ {
+		athlete := m.roster[i]
+		cursor := "  "
+		style := lipgloss.NewStyle().Foreground(textColor)
+		if i == m.rosterCursor {
+			cursor = "❯ "
+			style = selectedItemStyle.UnsetPadding()
+		}
+		age := ""
+		if athlete.Age > 0 {
+			age = fmt.Sprintf("%d", athlete.Age)
+		}
+		rows = append(rows, style.Render(fmt.Sprintf("%s%4s  %-4s %-26s %-8s %-9s %4s",
+			cursor, athlete.Jersey, athlete.Position, truncate(athlete.Name, 26), athlete.Height, athlete.Weight, age)))
+	}
+
+	scrollInfo := ""
+	if len(m.roster) > m.visibleRosterRows() {
+		scrollInfo = lipgloss.NewStyle().Foreground(dimColor).Render(
+			fmt.Sprintf(" (Showing %d-%d of %d players)", startIdx+1, endIdx, len(m.roster)))
+	}
+
+	help := m.renderHelp("↑/k up • ↓/j down • enter profile • esc back • q quit")
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		title+scrollInfo,
+		header,
+		lipgloss.JoinVertical(lipgloss.Left, rows...),
+		help,
+	)
+}
+
+func (m Model) renderPlayerView() string {
+	title := titleStyle.Render("🏆 Player")
+	if m.player != nil {
+		name := m.player.Name
+		if m.player.Jersey != "" {
+			name = fmt.Sprintf("#%s %s", m.player.Jersey, name)
+		}
+		title = titleStyle.Render("🏆 " + name)
+	}
+
+	if m.loadingPlayer {
+		return lipgloss.JoinVertical(lipgloss.Left, title, subtitleStyle.Render("Loading player..."))
+	}
+
+	if m.err != nil {
+		errorMsg := m.renderError()
+		help := m.renderHelp("esc back • q quit")
+		return lipgloss.JoinVertical(lipgloss.Left, title, "", errorMsg, "", help)
+	}
+
+	if m.player == nil {
+		return "No player selected"
+	}
+
+	p := m.player
+	sectionStyle := lipgloss.NewStyle().Bold(true).Foreground(accentColor)
+
+	var contentLines []string
+	contentLines = append(contentLines, sectionStyle.Render("👤 Bio"))
+	contentLines = append(contentLines, "")
+
+	bio := []api.Statistic{
+		{Label: "Team", Value: p.Team},
+		{Label: "Position", Value: p.PositionName},
+		{Label: "Height", Value: p.Height},
+		{Label: "Weight", Value: p.Weight},
+		{Label: "Born", Value: strings.TrimSpace(p.DateOfBirth)},
+		{Label: "Birthplace", Value: p.BirthPlace},
+		{Label: "College", Value: p.College},
+		{Label: "Experience", Value: p.Experience},
+	}
+	for _, line := range bio {
+		if line.Value == "" {
+			continue
+		}
+		contentLines = append(contentLines, venueStyle.Render(fmt.Sprintf("  %-12s %s", line.Label+":", line.Value)))
+	}
+	contentLines = append(contentLines, "")
+
+	if len(p.Stats) > 0 {
+		statsTitle := "Season Stats"
+		if p.StatsTitle != "" {
+			statsTitle = p.StatsTitle
+		}
+		contentLines = append(contentLines, sectionStyle.Render("📊 "+statsTitle))
+		contentLines = append(contentLines, "")
+		for _, stat := range p.Stats {
+			contentLines = append(contentLines, statusStyle.Render(fmt.Sprintf("  %-28s %s", stat.Label, stat.Value)))
+		}
+	}
+
+	availableHeight := m.height - 8 // Reserve for title, help and margins
+	startLine := m.playerScrollOffset
+	if startLine >= len(contentLines) {
+		startLine = 0
+	}
+	endLine := startLine + availableHeight
+	if endLine > len(contentLines) {
+		endLine = len(contentLines)
+	}
+
+	help := m.renderHelp("↑/k up • ↓/j down • esc back • q quit")
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		lipgloss.JoinVertical(lipgloss.Left, contentLines[startLine:endLine]...),
+		help,
+	)
+}
@@ -0,0 +1,283 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/elliota43/sportsterminal/api"
+)
+
+type standingsLoadedMsg struct {
+	id        uint64
+	standings *api.Standings
+	err       error
+}
+
+func loadStandingsCmd(provider api.Provider, req request, sport, league string) tea.Cmd {
+	return func() tea.Msg {
+		standings, err := provider.Standings(req.ctx, sport, league)
+		return standingsLoadedMsg{id: req.id, standings: standings, err: err}
+	}
+}
+
+// openStandings switches to the standings of the selected league,
+// remembering the view to return to.
+func (m Model) openStandings() (Model, tea.Cmd) {
+	m, req := m.beginRequest()
+	m.standingsReturn = m.state
+	m.state = standingsView
+	m.standings = nil
+	m.loadingStandings = true
+	m.standingsScrollOffset = 0
+	m.standingsSort = -1
+	m.standingsAscending = false
+	m.err = nil
+	return m, loadStandingsCmd(m.provider, req, m.selectedSport.ID, m.selectedLeague.ID)
+}
+
+// standingsColumn is one column of a standings table. value is used for
+// sorting, format for display.
+type standingsColumn struct {
+	title  string
+	width  int
+	value  func(e api.StandingsEntry) float64
+	format func(e api.StandingsEntry) string
+}
+
+func intColumn(title string, width int, field func(e api.StandingsEntry) int) standingsColumn {
+	return standingsColumn{
+		title:  title,
+		width:  width,
+		value:  func(e api.StandingsEntry) float64 { return float64(field(e)) },
+		format: func(e api.StandingsEntry) string { return strconv.Itoa(field(e)) },
+	}
+}
+
+var (
+	gpColumn  = intColumn("GP", 4, func(e api.StandingsEntry) int { return e.GamesPlayed })
+	wColumn   = intColumn("W", 4, func(e api.StandingsEntry) int { return e.Wins })
+	lColumn   = intColumn("L", 4, func(e api.StandingsEntry) int { return e.Losses })
+	tColumn   = intColumn("T", 4, func(e api.StandingsEntry) int { return e.Ties })
+	dColumn   = intColumn("D", 4, func(e api.StandingsEntry) int { return e.Ties })
+	otlColumn = intColumn("OTL", 4, func(e api.StandingsEntry) int { return e.OTLosses })
+	ptsColumn = intColumn("PTS", 5, func(e api.StandingsEntry) int { return e.Points })
+	pfColumn  = intColumn("PF", 5, func(e api.StandingsEntry) int { return e.PointsFor })
+	paColumn  = intColumn("PA", 5, func(e api.StandingsEntry) int { return e.PointsAgainst })
+	gfColumn  = intColumn("GF", 4, func(e api.StandingsEntry) int { return e.PointsFor })
+	gaColumn  = intColumn("GA", 4, func(e api.StandingsEntry) int { return e.PointsAgainst })
+
+	pctColumn = standingsColumn{
+		title: "PCT",
+		width: 6,
+		value: func(e api.StandingsEntry) float64 { return e.WinPercent },
+		format: func(e api.StandingsEntry) string {
+			return strings.TrimPrefix(fmt.Sprintf("%.3f", e.WinPercent), "0")
+		},
+	}
+
+	gbColumn = standingsColumn{
+		title: "GB",
+		width: 6,
+		value: func(e api.StandingsEntry) float64 { return e.GamesBehind },
+		format: func(e api.StandingsEntry) string {
+			if e.GamesBehind == 0 {
+				return "-"
+			}
+			return strconv.FormatFloat(e.GamesBehind, 'f', -1, 64)
+		},
+	}
+
+	diffColumn = standingsColumn{
+		title:  "DIFF",
+		width:  6,
+		value:  func(e api.StandingsEntry) float64 { return e.Differential },
+		format: func(e api.StandingsEntry) string { return formatDifferential(e.Differential) },
+	}
+
+	gdColumn = standingsColumn{
+		title:  "GD",
+		width:  5,
+		value:  diffColumn.value,
+		format: diffColumn.format,
+	}
+
+	streakColumn = standingsColumn{
+		title: "STRK",
+		width: 6,
+		value: func(e api.StandingsEntry) float64 {
+			// Rank winning streaks above losing ones
+			n, _ := strconv.Atoi(strings.TrimLeft(e.Streak, "WLTD"))
+			if strings.HasPrefix(e.Streak, "L") {
+				return float64(-n)
+			}
+			return float64(n)
+		},
+		format: func(e api.StandingsEntry) string { return e.Streak },
+	}
+)
+
+// standingsColumns returns the columns that make sense for a sport's table.
+func standingsColumns(sport string) []standingsColumn {
+	switch sport {
+	case "football":
+		return []standingsColumn{wColumn, lColumn, tColumn, pctColumn, pfColumn, paColumn, diffColumn, streakColumn}
+	case "basketball", "baseball":
+		return []standingsColumn{wColumn, lColumn, pctColumn, gbColumn, streakColumn}
+	case "hockey":
+		return []standingsColumn{gpColumn, wColumn, lColumn, otlColumn, ptsColumn, gfColumn, gaColumn, gdColumn, streakColumn}
+	case "soccer":
+		return []standingsColumn{gpColumn, wColumn, dColumn, lColumn, gfColumn, gaColumn, gdColumn, ptsColumn}
+	}
+	return []standingsColumn{wColumn, lColumn, tColumn, pctColumn}
+}
+
+func formatDifferential(v float64) string {
+	s := strconv.FormatFloat(v, 'f', -1, 64)
+	if v > 0 {
+		return "+" + s
+	}
+	return s
+}
+
+// standingsTable is a group with entries, named after its parent groups.
+type standingsTable struct {
+	name    string
+	entries []api.StandingsEntry
+}
+
+// flattenStandings turns nested groups into a list of tables, naming
+// divisions after their conference ("Eastern Conference › Atlantic").
+func flattenStandings(groups []api.StandingsGroup, prefix string) []standingsTable {
+	var tables []standingsTable
+	for _, g := range groups {
+		name := g.Name
+		if prefix != "" {
+			name = prefix + " › " + g.Name
+		}
+		if len(g.Entries) > 0 {
+			tables = append(tables, standingsTable{name: name, entries: g.Entries})
+		}
+		tables = append(tables, flattenStandings(g.Groups, name)...)
+	}
+	return tables
+}
+
+// sortedEntries returns the entries ordered by the selected column, or in
+// ESPN's order when no column is selected.
+func (m Model) sortedEntries(entries []api.StandingsEntry, columns []standingsColumn) []api.StandingsEntry {
+	sorted := make([]api.StandingsEntry, len(entries))
+	copy(sorted, entries)
+
+	if m.standingsSort < 0 || m.standingsSort >= len(columns) {
+		return sorted
+	}
+
+	value := columns[m.standingsSort].value
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if m.standingsAscending {
+			return value(sorted[i]) < value(sorted[j])
+		}
+		return value(sorted[i]) > value(sorted[j])
+	})
+	return sorted
+}
+
+func (m Model) renderStandingsView() string {
+	title := titleStyle.Render(fmt.Sprintf("🏆 %s - %s Standings", m.selectedSport.Name, m.selectedLeague.Name))
+
+	if m.loadingStandings {
+		return lipgloss.JoinVertical(lipgloss.Left, title, subtitleStyle.Render("Loading standings..."))
+	}
+
+	if m.err != nil {
+		errorMsg := m.renderError()
+		help := m.renderHelp("r refresh • esc back • q quit")
+		return lipgloss.JoinVertical(lipgloss.Left, title, "", errorMsg, "", help)
+	}
+
+	tables := []standingsTable{}
+	if m.standings != nil {
+		tables = flattenStandings(m.standings.Groups, "")
+	}
+	if len(tables) == 0 {
+		noStandings := itemStyle.Render("No standings available for this league.")
+		help := m.renderHelp("r refresh • esc back • q quit")
+		return lipgloss.JoinVertical(lipgloss.Left, title, "", noStandings, "", help)
+	}
+
+	columns := standingsColumns(m.selectedSport.ID)
+
+	var contentLines []string
+	for _, table := range tables {
+		contentLines = append(contentLines, lipgloss.NewStyle().Bold(true).Foreground(accentColor).Render(table.name))
+		contentLines = append(contentLines, "")
+
+		header := fmt.Sprintf("  %3s  %-28s", "#", "Team")
+		for i, col := range columns {
+			heading := col.title
+			if i == m.standingsSort {
+				if m.standingsAscending {
+					heading += "▲"
+				} else {
+					heading += "▼"
+				}
+			}
+			header += fmt.Sprintf(" %*s", col.width, heading)
+		}
+		contentLines = append(contentLines, lipgloss.NewStyle().Bold(true).Foreground(primaryColor).Render(header))
+
+		for i, entry := range m.sortedEntries(table.entries, columns) {
+			line := fmt.Sprintf("  %3d  %-28s", i+1, truncate(entry.Team, 28))
+			for _, col := range columns {
+				line += fmt.Sprintf(" %*s", col.width, col.format(entry))
+			}
+			contentLines = append(contentLines, lipgloss.NewStyle().Foreground(textColor).Render(line))
+		}
+		contentLines = append(contentLines, "")
+	}
+
+	availableHeight := m.height - 10 // Reserve for title, help and margins
+	startLine := m.standingsScrollOffset
+	if startLine >= len(contentLines) {
+		startLine = 0
+	}
+	endLine := startLine + availableHeight
+	if endLine > len(contentLines) {
+		endLine = len(contentLines)
+	}
+
+	scrollInfo := ""
+	if len(contentLines) > availableHeight {
+		scrollInfo = lipgloss.NewStyle().Foreground(dimColor).Render(
+			fmt.Sprintf(" (Scroll: %d/%d lines)", startLine+1, len(contentLines)))
+	}
+
+	sortLabel := "default order"
+	if m.standingsSort >= 0 && m.standingsSort < len(columns) {
+		sortLabel = "sorted by " + columns[m.standingsSort].title
+	}
+
+	help := m.renderHelp("↑/k up • ↓/j down • o sort column • O reverse • r refresh • esc back • q quit")
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		title+scrollInfo,
+		subtitleStyle.Render(sortLabel),
+		"",
+		lipgloss.JoinVertical(lipgloss.Left, contentLines[startLine:endLine]...),
+		help,
+	)
+}
+
+// truncate shortens s to at most n runes, marking the cut with an ellipsis.
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
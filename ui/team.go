@@ -0,0 +1,219 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/elliota43/sportsterminal/api"
+)
+
+type teamLoadedMsg struct {
+	id       uint64
+	team     *api.TeamInfo
+	schedule []api.Game
+	err      error
+}
+
+func loadTeamCmd(provider api.Provider, req request, sport, league, teamID string) tea.Cmd {
+	return func() tea.Msg {
+		team, err := provider.Team(req.ctx, sport, league, teamID)
+		if _, stale := api.StaleSince(err); err != nil && !stale {
+			return teamLoadedMsg{id: req.id, err: err}
+		}
+		schedule, scheduleErr := provider.TeamSchedule(req.ctx, sport, league, teamID)
+		if scheduleErr != nil {
+			// A failure outranks a stale team profile
+			err = scheduleErr
+		}
+		return teamLoadedMsg{id: req.id, team: team, schedule: schedule, err: err}
+	}
+}
+
+// openTeam switches to the team page for teamID, remembering the view to
+// return to.
+func (m Model) openTeam(teamID string) (Model, tea.Cmd) {
+	if teamID == "" || m.selectedSport == nil || m.selectedLeague == nil {
+		return m, nil
+	}
+	m, req := m.beginRequest()
+	m.teamReturn = m.state
+	m.state = teamView
+	m.team = nil
+	m.teamID = teamID
+	m.teamSchedule = nil
+	m.loadingTeam = true
+	m.teamScrollOffset = 0
+	m.err = nil
+	return m, loadTeamCmd(m.provider, req, m.selectedSport.ID, m.selectedLeague.ID, teamID)
+}
+
+// selectedTeam returns the ID and name of the away or home team of the
+// game under the cursor in the games view, or of the open game in the
+// detail view.
+func (m Model) selectedTeam(home bool) (id string, name string) {
+	switch m.state {
+	case gamesView:
+		if m.gameCursor < len(m.games) {
+			team := m.games[m.gameCursor].AwayTeam
+			if home {
+				team = m.games[m.gameCursor].HomeTeam
+			}
+			return team.ID, team.Name
+		}
+	case gameDetailView:
+		if m.selectedGameDetail != nil {
+			team := m.selectedGameDetail.AwayTeam
+			if home {
+				team = m.selectedGameDetail.HomeTeam
+			}
+			return team.ID, team.Name
+		}
+	}
+	return "", ""
+}
+
+// opponent returns the other side of game from teamID's perspective, and
+// whether teamID is the home team.
+func opponent(game api.Game, teamID string) (self api.Team, other api.Team, home bool) {
+	if game.HomeTeam.ID == teamID {
+		return game.HomeTeam, game.AwayTeam, true
+	}
+	return game.AwayTeam, game.HomeTeam, false
+}
+
+// scheduleLine renders one game from a team's schedule: date, opponent and
+// either the result or the start time.
+func (m Model) scheduleLine(game api.Game, teamID string) (string, lipgloss.Style) {
+	self, other, home := opponent(game, teamID)
+
+	versus := "@ "
+	if home {
+		versus = "vs"
+	}
+	opponentName := other.ShortName
+	if opponentName == "" {
+		opponentName = other.Name
+	}
+
+	date := game.Date.In(m.loc).Format("Mon Jan 2")
+	style := statusStyle
+
+	var outcome string
+	switch {
+	case game.Completed:
+		result := "L"
+		if self.Winner {
+			result = "W"
+			style = lipgloss.NewStyle().Foreground(textColor)
+		} else if !other.Winner {
+			result = "T"
+		}
+		outcome = fmt.Sprintf("%s %s-%s", result, self.Score, other.Score)
+	case game.IsLive:
+		outcome = fmt.Sprintf("LIVE %s-%s", self.Score, other.Score)
+		style = liveStyle
+	default:
+		outcome = game.Date.In(m.loc).Format(clockLayout)
+		style = lipgloss.NewStyle().Foreground(textColor)
+	}
+
+	return fmt.Sprintf("  %-10s %s %-22s %s", date, versus, truncate(opponentName, 22), outcome), style
+}
+
+func (m Model) renderTeamView() string {
+	title := titleStyle.Render("🏆 Team")
+	if m.team != nil {
+		title = titleStyle.Render(fmt.Sprintf("%s %s", displayTeamLogo(m.team.Logo, m.team.Name), m.team.Name))
+	}
+
+	if m.loadingTeam {
+		return lipgloss.JoinVertical(lipgloss.Left, title, subtitleStyle.Render("Loading team..."))
+	}
+
+	if m.err != nil {
+		errorMsg := m.renderError()
+		help := m.renderHelp("esc back • q quit")
+		return lipgloss.JoinVertical(lipgloss.Left, title, "", errorMsg, "", help)
+	}
+
+	if m.team == nil {
+		return "No team selected"
+	}
+
+	sectionStyle := lipgloss.NewStyle().Bold(true).Foreground(accentColor)
+
+	var contentLines []string
+
+	// Record
+	contentLines = append(contentLines, sectionStyle.Render("📊 Record"))
+	contentLines = append(contentLines, "")
+	summary := m.team.Record
+	if m.team.Standing != "" {
+		summary += " • " + m.team.Standing
+	}
+	contentLines = append(contentLines, teamStyle.Render("  "+summary))
+	var splits []string
+	for _, record := range m.team.Records {
+		if record.Value == m.team.Record {
+			continue
+		}
+		splits = append(splits, fmt.Sprintf("%s %s", record.Label, record.Value))
+	}
+	if len(splits) > 0 {
+		contentLines = append(contentLines, statusStyle.Render("  "+strings.Join(splits, " • ")))
+	}
+	contentLines = append(contentLines, "")
+
+	// Upcoming games
+	var upcoming []api.Game
+	for _, game := range m.teamSchedule {
+		if !game.Completed && len(upcoming) < 5 {
+			upcoming = append(upcoming, game)
+		}
+	}
+	contentLines = append(contentLines, sectionStyle.Render("📅 Upcoming"))
+	contentLines = append(contentLines, "")
+	if len(upcoming) == 0 {
+		contentLines = append(contentLines, statusStyle.Render("  No upcoming games scheduled."))
+	}
+	for _, game := range upcoming {
+		line, style := m.scheduleLine(game, m.team.ID)
+		contentLines = append(contentLines, style.Render(line))
+	}
+	contentLines = append(contentLines, "")
+
+	// Full season schedule
+	contentLines = append(contentLines, sectionStyle.Render("📋 Season Schedule"))
+	contentLines = append(contentLines, "")
+	for _, game := range m.teamSchedule {
+		line, style := m.scheduleLine(game, m.team.ID)
+		contentLines = append(contentLines, style.Render(line))
+	}
+
+	availableHeight := m.height - 10 // Reserve for title, help and margins
+	startLine := m.teamScrollOffset
+	if startLine >= len(contentLines) {
+		startLine = 0
+	}
+	endLine := startLine + availableHeight
+	if endLine > len(contentLines) {
+		endLine = len(contentLines)
+	}
+
+	scrollInfo := ""
+	if len(contentLines) > availableHeight {
+		scrollInfo = lipgloss.NewStyle().Foreground(dimColor).Render(
+			fmt.Sprintf(" (Scroll: %d/%d lines)", startLine+1, len(contentLines)))
+	}
+
+	help := m.renderHelp("↑/k up • ↓/j down • p roster • esc back • q quit")
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		title+scrollInfo,
+		lipgloss.JoinVertical(lipgloss.Left, contentLines[startLine:endLine]...),
+		help,
+	)
+}
@@ -0,0 +1,125 @@
+package ui
+
+import "github.com/charmbracelet/lipgloss"
+
+// theme is a color palette. Every style is derived from it.
+type theme struct {
+	primary lipgloss.TerminalColor
+	accent  lipgloss.TerminalColor
+	live    lipgloss.TerminalColor
+	text    lipgloss.TerminalColor
+	dim     lipgloss.TerminalColor
+
+	// selectedBorder outlines the highlighted game card.
+	selectedBorder lipgloss.Border
+}
+
+// themes are the palettes selectable with the config's theme setting.
+var themes = map[string]theme{
+	"default": {
+		primary: lipgloss.Color("#7C3AED"),
+		accent:  lipgloss.Color("#F59E0B"),
+		live:    lipgloss.Color("#EF4444"),
+		text:    lipgloss.Color("#E5E7EB"),
+		dim:     lipgloss.Color("#9CA3AF"),
+
+		selectedBorder: lipgloss.RoundedBorder(),
+	},
+	// light suits terminals with a light background.
+	"light": {
+		primary: lipgloss.Color("#5B21B6"),
+		accent:  lipgloss.Color("#B45309"),
+		live:    lipgloss.Color("#B91C1C"),
+		text:    lipgloss.Color("#111827"),
+		dim:     lipgloss.Color("#6B7280"),
+
+		selectedBorder: lipgloss.RoundedBorder(),
+	},
+	// mono uses the terminal's own colors and relies on bold text.
+	"mono": {
+		primary: lipgloss.NoColor{},
+		accent:  lipgloss.NoColor{},
+		live:    lipgloss.NoColor{},
+		text:    lipgloss.NoColor{},
+		dim:     lipgloss.NoColor{},
+
+		selectedBorder: lipgloss.ThickBorder(),
+	},
+}
+
+func init() {
+	setTheme("default")
+}
+
+// setTheme rebuilds the palette and styles from a named theme. Unknown
+// names fall back to the default theme.
+func setTheme(name string) {
+	t, ok := themes[name]
+	if !ok {
+		t = themes["default"]
+	}
+
+	primaryColor = t.primary
+	accentColor = t.accent
+	liveColor = t.live
+	textColor = t.text
+	dimColor = t.dim
+
+	titleStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(primaryColor).
+		Padding(1, 2).
+		MarginBottom(1)
+
+	subtitleStyle = lipgloss.NewStyle().
+		Foreground(dimColor).
+		Padding(0, 2)
+
+	itemStyle = lipgloss.NewStyle().
+		Foreground(textColor).
+		Padding(0, 2)
+
+	selectedItemStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(accentColor).
+		Padding(0, 2)
+
+	helpStyle = lipgloss.NewStyle().
+		Foreground(dimColor).
+		Padding(1, 2)
+
+	gameBoxStyle = lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(dimColor).
+		Padding(1, 2).
+		Width(60)
+
+	selectedGameBoxStyle = lipgloss.NewStyle().
+		Border(t.selectedBorder).
+		BorderForeground(primaryColor).
+		Padding(1, 2).
+		Width(60)
+
+	teamStyle = lipgloss.NewStyle().
+		Foreground(textColor).
+		Bold(true)
+
+	statusStyle = lipgloss.NewStyle().
+		Foreground(dimColor)
+
+	liveStyle = lipgloss.NewStyle().
+		Foreground(liveColor).
+		Bold(true)
+
+	venueStyle = lipgloss.NewStyle().
+		Foreground(dimColor)
+
+	dayHeaderStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(accentColor).
+		Padding(0, 2)
+
+	errorStyle = lipgloss.NewStyle().
+		Foreground(liveColor).
+		Padding(0, 2)
+}
@@ -0,0 +1,204 @@
+// Package watch turns successive scoreboard snapshots into events
+// describing what changed between them.
+package watch
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/elliota43/sportsterminal/api"
+)
+
+// EventType is the kind of change an Event reports.
+type EventType string
+
+const (
+	GameStarted   EventType = "game_started"
+	ScoreChanged  EventType = "score_changed"
+	LeadChanged   EventType = "lead_changed"
+	PeriodChanged EventType = "period_changed"
+	GameFinal     EventType = "game_final"
+)
+
+// Side identifies a team within a game.
+type Side string
+
+const (
+	Away Side = "away"
+	Home Side = "home"
+	Both Side = "both"
+)
+
+// Event is one change to a game between two snapshots.
+type Event struct {
+	Type EventType
+
+	// Game is the game as of the newer snapshot, Prev as of the older.
+	Game api.Game
+	Prev api.Game
+
+	// Scorer is the side whose score changed, for ScoreChanged events,
+	// or the new leader, for LeadChanged events.
+	Scorer Side
+}
+
+// Leaders remembers, by game ID, the side last ahead in each game a
+// watcher has seen, through any ties since. It lets Diff tell a team
+// going back in front after a tie from a lead change.
+type Leaders map[string]Side
+
+// Diff compares two snapshots of the same scoreboard and returns the
+// events that turn prev into next. Games missing from prev are new to the
+// watcher and produce no events, so the first snapshot is a baseline.
+// Events for a game come in the order started, score, lead, period, final.
+//
+// Diff records the leaders in next in leaders, which carries them from
+// one call to the next. With nil leaders, a lead change is judged from
+// prev alone, so a tie in prev hides who led before it.
+func Diff(prev, next []api.Game, leaders Leaders) []Event {
+	before := make(map[string]api.Game, len(prev))
+	for _, game := range prev {
+		before[game.ID] = game
+	}
+
+	var events []Event
+	for _, game := range next {
+		old, ok := before[game.ID]
+		last, known := leaders[game.ID]
+		if !known && ok {
+			last = leader(old)
+		}
+		if lead := leader(game); lead != "" && leaders != nil {
+			leaders[game.ID] = lead
+		}
+		if !ok {
+			continue
+		}
+		events = append(events, diffGame(old, game, last)...)
+	}
+	return events
+}
+
+// diffGame returns the events between two snapshots of a game, last
+// being the side ahead most recently before game.
+func diffGame(old, game api.Game, last Side) []Event {
+	var events []Event
+	event := func(t EventType) Event {
+		return Event{Type: t, Game: game, Prev: old}
+	}
+
+	if !started(old) && started(game) {
+		events = append(events, event(GameStarted))
+	}
+
+	awayChanged := old.AwayTeam.Score != game.AwayTeam.Score
+	homeChanged := old.HomeTeam.Score != game.HomeTeam.Score
+	if awayChanged || homeChanged {
+		e := event(ScoreChanged)
+		switch {
+		case awayChanged && homeChanged:
+			e.Scorer = Both
+		case awayChanged:
+			e.Scorer = Away
+		default:
+			e.Scorer = Home
+		}
+		events = append(events, e)
+
+		// A lead change is a new team in front, not a tie or the same
+		// team going back ahead after one
+		if lead := leader(game); lead != "" && lead != last {
+			e := event(LeadChanged)
+			e.Scorer = lead
+			events = append(events, e)
+		}
+	}
+
+	if game.Period > old.Period && old.Period > 0 && !game.Completed {
+		events = append(events, event(PeriodChanged))
+	}
+
+	if !old.Completed && game.Completed {
+		events = append(events, event(GameFinal))
+	}
+
+	return events
+}
+
+// started reports whether a game is under way or over.
+func started(game api.Game) bool {
+	switch game.State {
+	case "in", "post":
+		return true
+	case "pre":
+		return false
+	}
+	return game.IsLive || game.Completed
+}
+
+// leader returns the side ahead in a game, or "" when level or unscored.
+func leader(game api.Game) Side {
+	away, errAway := strconv.Atoi(game.AwayTeam.Score)
+	home, errHome := strconv.Atoi(game.HomeTeam.Score)
+	switch {
+	case errAway != nil || errHome != nil || away == home:
+		return ""
+	case away > home:
+		return Away
+	}
+	return Home
+}
+
+// ScoringTeam returns the team that scored in a ScoreChanged event with a
+// single scorer, or the new leader in a LeadChanged event.
+func (e Event) ScoringTeam() (api.Team, bool) {
+	switch e.Scorer {
+	case Away:
+		return e.Game.AwayTeam, true
+	case Home:
+		return e.Game.HomeTeam, true
+	}
+	return api.Team{}, false
+}
+
+// Scoreline formats the game's current score, e.g. "Knicks 66 - 70 Celtics".
+func (e Event) Scoreline() string {
+	return fmt.Sprintf("%s %s - %s %s",
+		teamName(e.Game.AwayTeam), e.Game.AwayTeam.Score,
+		e.Game.HomeTeam.Score, teamName(e.Game.HomeTeam))
+}
+
+// String describes the event in a sentence.
+func (e Event) String() string {
+	switch e.Type {
+	case GameStarted:
+		return fmt.Sprintf("%s @ %s has started", teamName(e.Game.AwayTeam), teamName(e.Game.HomeTeam))
+	case LeadChanged:
+		team, _ := e.ScoringTeam()
+		return fmt.Sprintf("%s take the lead: %s", teamName(team), e.Scoreline())
+	case ScoreChanged:
+		if team, ok := e.ScoringTeam(); ok {
+			return fmt.Sprintf("%s scored: %s", teamName(team), e.Scoreline())
+		}
+		return fmt.Sprintf("Score update: %s", e.Scoreline())
+	case PeriodChanged:
+		return fmt.Sprintf("%s • %s", e.Scoreline(), status(e.Game))
+	case GameFinal:
+		return fmt.Sprintf("%s: %s", status(e.Game), e.Scoreline())
+	}
+	return e.Scoreline()
+}
+
+func status(game api.Game) string {
+	if game.StatusDetail != "" {
+		return game.StatusDetail
+	}
+	return game.Status
+}
+
+func teamName(team api.Team) string {
+	if team.ShortName != "" {
+		return team.ShortName
+	}
+	return team.Name
+}
@@ -0,0 +1,136 @@
+package watch
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/elliota43/sportsterminal/api"
+)
+
+// game returns a snapshot of game 1 between the Knicks and the Celtics.
+func game(state string, period int, away, home string) api.Game {
+	return api.Game{
+		ID:        "1",
+		State:     state,
+		Period:    period,
+		IsLive:    state == "in",
+		Completed: state == "post",
+		AwayTeam:  api.Team{ID: "18", ShortName: "Knicks", Score: away},
+		HomeTeam:  api.Team{ID: "2", ShortName: "Celtics", Score: home},
+	}
+}
+
+type change struct {
+	Type   EventType
+	Scorer Side
+}
+
+func changes(events []Event) []change {
+	var out []change
+	for _, e := range events {
+		out = append(out, change{e.Type, e.Scorer})
+	}
+	return out
+}
+
+func TestDiffBaseline(t *testing.T) {
+	next := []api.Game{game("in", 2, "40", "38")}
+	if events := Diff(nil, next, Leaders{}); len(events) != 0 {
+		t.Errorf("first snapshot gave %v, want no events", changes(events))
+	}
+
+	other := game("in", 2, "40", "38")
+	other.ID = "2"
+	if events := Diff([]api.Game{other}, next, Leaders{}); len(events) != 0 {
+		t.Errorf("game new to the snapshot gave %v, want no events", changes(events))
+	}
+}
+
+func TestDiffOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		prev, next api.Game
+		want       []change
+	}{
+		{
+			"start",
+			game("pre", 0, "", ""), game("in", 1, "0", "0"),
+			[]change{{GameStarted, ""}, {ScoreChanged, Both}},
+		},
+		{
+			"score without a lead change",
+			game("in", 1, "10", "8"), game("in", 1, "12", "8"),
+			[]change{{ScoreChanged, Away}},
+		},
+		{
+			"tie",
+			game("in", 1, "10", "8"), game("in", 1, "10", "10"),
+			[]change{{ScoreChanged, Home}},
+		},
+		{
+			"lead change",
+			game("in", 1, "10", "8"), game("in", 1, "10", "11"),
+			[]change{{ScoreChanged, Home}, {LeadChanged, Home}},
+		},
+		{
+			"score, lead and period",
+			game("in", 1, "20", "19"), game("in", 2, "20", "22"),
+			[]change{{ScoreChanged, Home}, {LeadChanged, Home}, {PeriodChanged, ""}},
+		},
+		{
+			"score and final",
+			game("in", 4, "99", "99"), game("post", 5, "99", "101"),
+			[]change{{ScoreChanged, Home}, {LeadChanged, Home}, {GameFinal, ""}},
+		},
+		{
+			"everything at once",
+			game("pre", 0, "0", "0"), game("post", 4, "101", "99"),
+			[]change{{GameStarted, ""}, {ScoreChanged, Both}, {LeadChanged, Away}, {GameFinal, ""}},
+		},
+		{
+			"no change",
+			game("in", 3, "70", "66"), game("in", 3, "70", "66"),
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		got := changes(Diff([]api.Game{tt.prev}, []api.Game{tt.next}, Leaders{}))
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Diff = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDiffLeadAfterTie(t *testing.T) {
+	snapshots := []struct {
+		game api.Game
+		want []change
+	}{
+		{game("in", 1, "10", "8"), nil},
+		{game("in", 1, "10", "10"), []change{{ScoreChanged, Home}}},
+		// The Knicks were ahead before the tie: no lead change
+		{game("in", 1, "12", "10"), []change{{ScoreChanged, Away}}},
+		{game("in", 1, "12", "12"), []change{{ScoreChanged, Home}}},
+		{game("in", 2, "12", "14"), []change{{ScoreChanged, Home}, {LeadChanged, Home}, {PeriodChanged, ""}}},
+	}
+
+	leaders := Leaders{}
+	var prev []api.Game
+	for i, snapshot := range snapshots {
+		next := []api.Game{snapshot.game}
+		got := changes(Diff(prev, next, leaders))
+		if !reflect.DeepEqual(got, snapshot.want) {
+			t.Errorf("snapshot %d: Diff = %v, want %v", i, got, snapshot.want)
+		}
+		prev = next
+	}
+}
+
+func TestDiffWithoutLeaders(t *testing.T) {
+	prev := []api.Game{game("in", 1, "10", "10")}
+	next := []api.Game{game("in", 1, "12", "10")}
+	want := []change{{ScoreChanged, Away}, {LeadChanged, Away}}
+	if got := changes(Diff(prev, next, nil)); !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff = %v, want %v", got, want)
+	}
+}